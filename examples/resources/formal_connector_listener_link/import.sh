@@ -0,0 +1,2 @@
+# A connector listener link can be imported by its ID, or by the IDs of its connector and connector listener.
+terraform import formal_connector_listener_link.example connector_id/connector_listener_id
@@ -0,0 +1,2 @@
+# A group user link can be imported by its ID, or by the IDs of its group and user.
+terraform import formal_group_user_link.example group_id/user_id
@@ -0,0 +1,3 @@
+# A data label link can be imported by the ID of its column, or by the ID of the resource and the path
+# of the column.
+terraform import formal_inventory_object_data_label_link.example resource_id/main.public.users.email
@@ -0,0 +1,3 @@
+# A native user link can be imported by its ID, or by the IDs of the resource and the native user, and
+# the type and ID of the Formal Identity, either user or group.
+terraform import formal_native_user_link.example resource_id/native_user_id/user/user_id
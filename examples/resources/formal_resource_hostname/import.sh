@@ -0,0 +1,2 @@
+# A resource hostname can be imported by its ID, or by the ID of its resource and the hostname.
+terraform import formal_resource_hostname.example resource_id/replica.example.com
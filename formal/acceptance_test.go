@@ -0,0 +1,593 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+// testAccPrefix is the prefix of the names of the objects created by
+// acceptance tests.
+const testAccPrefix = "tf-acc-"
+
+// testAccProtoV5ProviderFactories instantiate the provider during acceptance
+// tests, muxing the SDKv2 and terraform-plugin-framework providers as main
+// does.
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"formal": func() (tfprotov5.ProviderServer, error) {
+		providerServer, err := NewProviderServer(context.Background(), "test")
+		if err != nil {
+			return nil, err
+		}
+		return providerServer(), nil
+	},
+}
+
+// testAccName returns a unique name for an object created by an acceptance
+// test.
+func testAccName() string {
+	return testAccPrefix + acctest.RandString(8)
+}
+
+// testAccTerminationProtectionError is returned when destroying a resource
+// with termination_protection.
+var testAccTerminationProtectionError = regexp.MustCompile("cannot be deleted because termination_protection is set to true")
+
+// testAccCheckDestroy checks that the objects of the resources of the given
+// type no longer exist in the API.
+func testAccCheckDestroy(server *fakeapi.Server, resourceType string, typeName protoreflect.FullName) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if testAccObject(server, typeName, rs.Primary.ID) != nil {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckExists checks that the object of a resource exists in the API.
+func testAccCheckExists(server *fakeapi.Server, name string, typeName protoreflect.FullName) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceID(s, name)
+		if err != nil {
+			return err
+		}
+		if testAccObject(server, typeName, id) == nil {
+			return fmt.Errorf("%s %s doesn't exist", name, id)
+		}
+		return nil
+	}
+}
+
+// testAccCheckDisappears deletes the object of a resource outside of
+// Terraform, so that the next plan recreates it.
+func testAccCheckDisappears(server *fakeapi.Server, name string, typeName protoreflect.FullName) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceID(s, name)
+		if err != nil {
+			return err
+		}
+		if !server.Delete(typeName, id) {
+			return fmt.Errorf("%s %s doesn't exist", name, id)
+		}
+		return nil
+	}
+}
+
+// testAccStoreID stores the ID of a resource, to compare it in a later step
+// with testAccCheckReplaced.
+func testAccStoreID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) (err error) {
+		*id, err = testAccResourceID(s, name)
+		return err
+	}
+}
+
+// testAccCheckReplaced checks that a resource was replaced since its ID was
+// stored with testAccStoreID.
+func testAccCheckReplaced(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		newID, err := testAccResourceID(s, name)
+		if err != nil {
+			return err
+		}
+		if newID == *id {
+			return fmt.Errorf("%s wasn't replaced, its ID is still %s", name, newID)
+		}
+		return nil
+	}
+}
+
+// testAccImportStateIDFromAttribute imports a resource by the value of one
+// of its attributes instead of its ID.
+func testAccImportStateIDFromAttribute(name, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+		return rs.Primary.Attributes[attribute], nil
+	}
+}
+
+// testAccImportStateIDFromKey imports a resource by its natural key, the
+// values of the given attributes joined by slashes.
+func testAccImportStateIDFromKey(name string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+		values := make([]string, len(attributes))
+		for i, attribute := range attributes {
+			values[i] = rs.Primary.Attributes[attribute]
+		}
+		return strings.Join(values, "/"), nil
+	}
+}
+
+func testAccResourceID(s *terraform.State, name string) (string, error) {
+	rs, ok := s.RootModule().Resources[name]
+	if !ok {
+		return "", fmt.Errorf("resource %s not found in state", name)
+	}
+	if rs.Primary.ID == "" {
+		return "", fmt.Errorf("resource %s has no ID", name)
+	}
+	return rs.Primary.ID, nil
+}
+
+func testAccObject(server *fakeapi.Server, typeName protoreflect.FullName, id string) protoreflect.Message {
+	for _, obj := range server.Objects(typeName) {
+		if field := obj.Descriptor().Fields().ByName("id"); field != nil && obj.Get(field).String() == id {
+			return obj
+		}
+	}
+	return nil
+}
+
+// testAccPut stores an object in the API as if it was created outside of
+// Terraform, and returns its id.
+func testAccPut(t *testing.T, server *fakeapi.Server, typeName protoreflect.FullName, object string) string {
+	t.Helper()
+
+	id, err := server.Put(typeName, object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// testAccResource describes a resource for the tests every resource shares,
+// which create it under the address <resourceType>.test.
+type testAccResource struct {
+	resourceType string
+	typeName     protoreflect.FullName
+	// setup prepares the fake API, for example with the handlers of methods
+	// it doesn't implement. Optional.
+	setup func(server *fakeapi.Server)
+	// config returns the configuration of the resource and of the resources
+	// it depends on, with name for their names.
+	config func(name string, terminationProtection bool) string
+	// dependencies returns the configuration of the resources the resource
+	// depends on, which are left once it's destroyed. Optional.
+	dependencies func(name string) string
+	// terminationProtection is true if the resource has a
+	// termination_protection attribute.
+	terminationProtection bool
+}
+
+var testAccResources = []testAccResource{
+	{
+		resourceType: "formal_connector_ai_provider",
+		typeName:     "core.v1.ConnectorAiProvider",
+		config: func(name string, _ bool) string {
+			return testAccConnectorAiProviderConfig(name, "us-east-1")
+		},
+	},
+	{
+		resourceType: "formal_connector_configuration",
+		typeName:     "core.v1.ConnectorConfiguration",
+		config: func(name string, _ bool) string {
+			return testAccConnectorConfigurationConfig(name, "info", 60)
+		},
+	},
+	{
+		resourceType: "formal_connector_hostname",
+		typeName:     "core.v1.ConnectorHostname",
+		config: func(name string, terminationProtection bool) string {
+			return testAccConnectorHostnameConfig(name, "connector.example.com", terminationProtection)
+		},
+		dependencies: func(name string) string {
+			return testAccConnectorConfig(name, false)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_connector_listener_link",
+		typeName:     "core.v1.ConnectorListenerLink",
+		config: func(name string, terminationProtection bool) string {
+			return testAccConnectorListenerLinkConfig(name, "first", terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_connector_listener_rule",
+		typeName:     "core.v1.ConnectorListenerRule",
+		config: func(name string, terminationProtection bool) string {
+			return testAccConnectorListenerRuleConfig(name, "any", "any", terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_connector_listener",
+		typeName:     "core.v1.ConnectorListener",
+		config: func(name string, terminationProtection bool) string {
+			return testAccConnectorListenerConfig(name, 5432, terminationProtection)
+		},
+		dependencies: func(name string) string {
+			return testAccConnectorConfig(name, false)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_connector_satellite_link",
+		typeName:     "core.v1.ConnectorSatelliteLink",
+		config: func(name string, _ bool) string {
+			return testAccConnectorSatelliteLinkConfig(name, "ai")
+		},
+	},
+	{
+		resourceType: "formal_connector",
+		typeName:     "core.v1.Connector",
+		config: func(name string, terminationProtection bool) string {
+			return testAccConnectorConfig(name, terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_connector_token_encryption_key",
+		typeName:     "core.v1.ConnectorTokenEncryptionKey",
+		config: func(name string, _ bool) string {
+			return testAccConnectorTokenEncryptionKeyConfig(name, "arn:aws:kms:us-east-1:123456789012:key/first")
+		},
+	},
+	{
+		resourceType: "formal_data_discovery",
+		typeName:     "core.v1.DataDiscoveryConfiguration",
+		config: func(name string, _ bool) string {
+			return testAccDataDiscoveryConfig(name, "24h", "delete")
+		},
+	},
+	{
+		resourceType: "formal_data_label",
+		typeName:     "core.v1.DataLabel",
+		config: func(name string, _ bool) string {
+			return testAccDataLabelConfig(name, "prompt", "Email addresses")
+		},
+	},
+	{
+		resourceType: "formal_encryption_key",
+		typeName:     "core.v1.EncryptionKey",
+		config: func(_ string, _ bool) string {
+			return testAccEncryptionKeyConfig("gcp-kms", "projects/p/locations/global/keyRings/r/cryptoKeys/k", "")
+		},
+	},
+	{
+		resourceType: "formal_form",
+		typeName:     "core.v1.Form",
+		config: func(name string, _ bool) string {
+			return testAccFormConfig(name, "Reason")
+		},
+	},
+	{
+		resourceType: "formal_group",
+		typeName:     "core.v1.Group",
+		config: func(name string, terminationProtection bool) string {
+			return testAccGroupConfig(name, terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_group_user_link",
+		typeName:     "core.v1.UserGroupLink",
+		config: func(name string, _ bool) string {
+			return testAccGroupUserLinkConfig(name, "first")
+		},
+	},
+	{
+		resourceType: "formal_hook",
+		typeName:     "core.v1.Hook",
+		config: func(_ string, _ bool) string {
+			return testAccHookConfig("draft", 5000, "AWS_REGION")
+		},
+	},
+	{
+		resourceType: "formal_integration_bi",
+		typeName:     "core.v1.BIIntegration",
+		config: func(name string, _ bool) string {
+			return testAccIntegrationBIConfig(name, "metabase.example.com")
+		},
+	},
+	{
+		resourceType: "formal_integration_cloud",
+		typeName:     "core.v1.CloudIntegration",
+		config: func(name string, _ bool) string {
+			return testAccIntegrationCloudGCPConfig(name, false)
+		},
+	},
+	{
+		resourceType: "formal_integration_log",
+		typeName:     "core.v1.IntegrationLog",
+		config: func(name string, _ bool) string {
+			return testAccIntegrationLogGCSConfig(name, "logs", "none")
+		},
+	},
+	{
+		resourceType: "formal_integration_mdm",
+		typeName:     "core.v1.IntegrationMDM",
+		config: func(name string, _ bool) string {
+			return testAccIntegrationMDMKandjiConfig(name, "https://tf-acc.api.kandji.io")
+		},
+	},
+	{
+		resourceType: "formal_integration_oidc",
+		typeName:     "core.v1.IntegrationOIDC",
+		config: func(name string, _ bool) string {
+			return testAccIntegrationOIDCConfig(name, "https://token.actions.githubusercontent.com", "active")
+		},
+	},
+	{
+		resourceType: "formal_inventory_object",
+		typeName:     "core.v1.InventoryObject",
+		setup:        testAccHandleInventory,
+		config: func(name string, _ bool) string {
+			return testAccInventoryObjectConfig(name, "users")
+		},
+	},
+	{
+		resourceType: "formal_log_configuration",
+		typeName:     "core.v1.LogConfiguration",
+		config: func(name string, _ bool) string {
+			return testAccLogConfigurationConfig(name, false, 1024)
+		},
+	},
+	{
+		resourceType: "formal_native_user_link",
+		typeName:     "core.v1.NativeUserLink",
+		config: func(name string, terminationProtection bool) string {
+			return testAccNativeUserLinkConfig(name, terminationProtection)
+		},
+		dependencies: func(name string) string {
+			return testAccNativeUserConfig(name, "postgres", false, false) + testAccUserMachineConfig(name)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_native_user",
+		typeName:     "core.v1.NativeUser",
+		config: func(name string, terminationProtection bool) string {
+			return testAccNativeUserConfig(name, "postgres", false, terminationProtection)
+		},
+		dependencies: func(name string) string {
+			return testAccResourceConfig(name, "postgres", false)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_network_rule",
+		typeName:     "core.v1.DesktopRoutingRule",
+		config: func(name string, terminationProtection bool) string {
+			return testAccNetworkRuleTerminationProtectionConfig(name, terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_permission",
+		typeName:     "core.v1.Permission",
+		config: func(name string, terminationProtection bool) string {
+			return testAccPermissionConfig(name, "draft", "block", terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_policy_data_loader",
+		typeName:     "core.v1.PolicyDataLoader",
+		config: func(name string, terminationProtection bool) string {
+			return testAccPolicyDataLoaderConfig(name, "draft", "*/10 * * * * *", terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_policy",
+		typeName:     "core.v1.Policy",
+		config: func(name string, terminationProtection bool) string {
+			return testAccPolicyConfig(name, "draft", "block", terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_resource_classifier_configuration",
+		typeName:     "core.v1.ResourceClassifierConfiguration",
+		config: func(name string, _ bool) string {
+			return testAccResourceClassifierConfigurationConfig(name, "nlp", "request", 10)
+		},
+	},
+	{
+		resourceType: "formal_resource_dial_configuration",
+		typeName:     "core.v1.ResourceDialConfiguration",
+		config: func(name string, _ bool) string {
+			return testAccResourceDialConfigurationConfig(name, "tcp", "")
+		},
+	},
+	{
+		resourceType: "formal_resource_health_check",
+		typeName:     "core.v1.ResourceHealthCheck",
+		config: func(name string, _ bool) string {
+			return testAccResourceHealthCheckConfig(name, "postgres")
+		},
+	},
+	{
+		resourceType: "formal_resource_hostname",
+		typeName:     "core.v1.ResourceHostname",
+		config: func(name string, terminationProtection bool) string {
+			return testAccResourceHostnameConfig(name, "test", "replica.example.com", terminationProtection)
+		},
+		dependencies: func(name string) string {
+			return testAccResourceConfig(name, "postgres", false)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_resource_ssh_host_key",
+		typeName:     "core.v1.ResourceSshHostKey",
+		config: func(name string, _ bool) string {
+			return testAccResourceSSHHostKeyConfig(name, "test", testAccSSHHostKey)
+		},
+	},
+	{
+		resourceType: "formal_resource",
+		typeName:     "core.v1.Resource",
+		config: func(name string, terminationProtection bool) string {
+			return testAccResourceConfig(name, "postgres", terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_resource_tls_configuration",
+		typeName:     "core.v1.ResourceTlsConfiguration",
+		config: func(name string, _ bool) string {
+			return testAccResourceTLSConfigurationConfig(name, "verify-full", "TLSv1.3")
+		},
+	},
+	{
+		resourceType: "formal_satellite_hostname",
+		typeName:     "core.v1.SatelliteHostname",
+		config: func(name string, terminationProtection bool) string {
+			return testAccSatelliteHostnameConfig(name, "satellite.example.com", terminationProtection)
+		},
+		dependencies: func(name string) string {
+			return testAccSatelliteConfig(name, false)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_satellite_link",
+		typeName:     "core.v1.SatelliteLink",
+		config: func(name string, _ bool) string {
+			return testAccSatelliteLinkConfig(name, "first")
+		},
+	},
+	{
+		resourceType: "formal_satellite",
+		typeName:     "core.v1.Satellite",
+		config: func(name string, terminationProtection bool) string {
+			return testAccSatelliteConfig(name, terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_space",
+		typeName:     "core.v1.Space",
+		config: func(name string, terminationProtection bool) string {
+			return testAccSpaceConfig(name, "", terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_user",
+		typeName:     "core.v1.User",
+		config: func(name string, terminationProtection bool) string {
+			return testAccUserHumanConfig(name+"@example.com", terminationProtection)
+		},
+		terminationProtection: true,
+	},
+	{
+		resourceType: "formal_workflow",
+		typeName:     "core.v1.Workflow",
+		config: func(name string, _ bool) string {
+			return testAccWorkflowConfig(name, "draft")
+		},
+	},
+}
+
+// TestAccResources_disappears checks that each resource deleted outside of
+// Terraform is planned to be created again.
+func TestAccResources_disappears(t *testing.T) {
+	for _, tc := range testAccResources {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			server := fakeapi.NewServer(t)
+			if tc.setup != nil {
+				tc.setup(server)
+			}
+			address := tc.resourceType + ".test"
+
+			resource.Test(t, resource.TestCase{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				CheckDestroy:             testAccCheckDestroy(server, tc.resourceType, tc.typeName),
+				Steps: []resource.TestStep{
+					{
+						Config:             server.ProviderConfig() + tc.config(testAccName(), false),
+						Check:              testAccCheckDisappears(server, address, tc.typeName),
+						ExpectNonEmptyPlan: true,
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestAccResources_terminationProtection checks that each resource with
+// termination_protection can't be destroyed until it's turned off.
+func TestAccResources_terminationProtection(t *testing.T) {
+	for _, tc := range testAccResources {
+		if !tc.terminationProtection {
+			continue
+		}
+		t.Run(tc.resourceType, func(t *testing.T) {
+			server := fakeapi.NewServer(t)
+			if tc.setup != nil {
+				tc.setup(server)
+			}
+			name := testAccName()
+			address := tc.resourceType + ".test"
+			var dependencies string
+			if tc.dependencies != nil {
+				dependencies = tc.dependencies(name)
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				CheckDestroy:             testAccCheckDestroy(server, tc.resourceType, tc.typeName),
+				Steps: []resource.TestStep{
+					{
+						Config: server.ProviderConfig() + tc.config(name, true),
+						Check:  resource.TestCheckResourceAttr(address, "termination_protection", "true"),
+					},
+					{
+						Config:      server.ProviderConfig() + dependencies,
+						ExpectError: testAccTerminationProtectionError,
+					},
+					{
+						Config: server.ProviderConfig() + tc.config(name, false),
+						Check:  resource.TestCheckResourceAttr(address, "termination_protection", "false"),
+					},
+				},
+			})
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package api
 
 import (
-	"errors"
+	"net/http"
 	"os"
+	"time"
 
+	"connectrpc.com/connect"
 	formal "github.com/formalco/go-sdk/v3"
 )
 
@@ -12,16 +14,99 @@ type GrpcClient struct {
 	Sdk                  *formal.Client
 }
 
-func NewClient(apiKey string, returnSensitiveValue bool) (*GrpcClient, error) {
-	opts := []formal.Option{formal.WithAPIKey(apiKey)}
-	if os.Getenv("FORMAL_ENV") == "dev" {
-		url := os.Getenv("FORMAL_DEV_URL")
-		if url == "" {
-			return nil, errors.New("FORMAL_ENV=dev requires FORMAL_DEV_URL")
-		}
-		opts = append(opts, formal.WithBaseURL(url))
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	baseURL               string
+	httpClient            *http.Client
+	retry                 retryPolicy
+	requestsPerSecond     int
+	maxConcurrentRequests int
+	logBodies             bool
+	readOnly              bool
+}
+
+// WithBaseURL points the client at a Formal API other than the SDK default.
+func WithBaseURL(url string) Option {
+	return func(o *clientOptions) {
+		o.baseURL = url
+	}
+}
+
+// WithHTTPClient sends requests through httpClient, see NewHTTPClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithRetry sets how many times a request failing with a transient error is
+// retried, and the longest time to wait between two attempts.
+func WithRetry(maxRetries int, maxWait time.Duration) Option {
+	return func(o *clientOptions) {
+		o.retry.maxRetries = maxRetries
+		o.retry.maxWait = maxWait
+	}
+}
+
+// WithRateLimit caps the average number of requests sent per second and the
+// number of requests in flight. Zero disables the corresponding limit.
+func WithRateLimit(requestsPerSecond, maxConcurrentRequests int) Option {
+	return func(o *clientOptions) {
+		o.requestsPerSecond = requestsPerSecond
+		o.maxConcurrentRequests = maxConcurrentRequests
+	}
+}
+
+// WithReadOnly rejects every request to a method that may modify something,
+// before it is sent.
+func WithReadOnly(readOnly bool) Option {
+	return func(o *clientOptions) {
+		o.readOnly = readOnly
+	}
+}
+
+func NewClient(apiKey string, returnSensitiveValue bool, opts ...Option) (*GrpcClient, error) {
+	o := clientOptions{
+		retry: retryPolicy{
+			maxRetries: DefaultMaxRetries,
+			maxWait:    DefaultRetryMaxWait,
+			baseDelay:  retryBaseDelay,
+		},
+		logBodies: os.Getenv("FORMAL_LOG_BODIES") == "1",
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// Limits apply to each attempt, so they sit inside the retry interceptor
+	// and a request waiting to be retried doesn't hold a concurrency slot.
+	var interceptors []connect.Interceptor
+	if o.readOnly {
+		interceptors = append(interceptors, newReadOnlyInterceptor())
+	}
+	interceptors = append(interceptors, newRetryInterceptor(o.retry))
+	if o.requestsPerSecond > 0 {
+		interceptors = append(interceptors, newRateLimitInterceptor(o.requestsPerSecond))
+	}
+	if o.maxConcurrentRequests > 0 {
+		interceptors = append(interceptors, newConcurrencyLimitInterceptor(o.maxConcurrentRequests))
+	}
+	// Tracing is innermost so that each attempt is logged without the time
+	// spent waiting for the limits.
+	interceptors = append(interceptors, newTracingInterceptor(o.logBodies))
+
+	sdkOpts := []formal.Option{
+		formal.WithAPIKey(apiKey),
+		formal.WithInterceptors(interceptors...),
+	}
+	if o.baseURL != "" {
+		sdkOpts = append(sdkOpts, formal.WithBaseURL(o.baseURL))
+	}
+	if o.httpClient != nil {
+		sdkOpts = append(sdkOpts, formal.WithHTTPClient(o.httpClient))
 	}
-	client, err := formal.New(opts...)
+	client, err := formal.New(sdkOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,5 @@
+package api
+
+// HumanIdentityPrefix prefixes the email of a human user in its identity, the
+// db_username of a formal_user.
+const HumanIdentityPrefix = "idp:formal:human:"
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+)
+
+// newRateLimitInterceptor delays unary calls so that no more than
+// requestsPerSecond are sent on average, allowing bursts of the same size.
+func newRateLimitInterceptor(requestsPerSecond int) connect.UnaryInterceptorFunc {
+	limiter := rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond)
+
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// newConcurrencyLimitInterceptor caps the number of unary calls in flight.
+func newConcurrencyLimitInterceptor(maxConcurrentRequests int) connect.UnaryInterceptorFunc {
+	slots := make(chan struct{}, maxConcurrentRequests)
+
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			defer func() { <-slots }()
+
+			return next(ctx, req)
+		}
+	}
+}
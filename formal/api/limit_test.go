@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestConcurrencyLimitInterceptorCapsRequestsInFlight(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+	call := newConcurrencyLimitInterceptor(2)(next)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := call(t.Context(), connect.NewRequest(&emptypb.Empty{}))
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestConcurrencyLimitInterceptorStopsWaitingWhenCanceled(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		close(started)
+		<-release
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+	call := newConcurrencyLimitInterceptor(1)(next)
+
+	go call(t.Context(), connect.NewRequest(&emptypb.Empty{}))
+	defer close(release)
+	<-started
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	_, err := call(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimitInterceptorSpacesRequests(t *testing.T) {
+	calls := 0
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		calls++
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+	call := newRateLimitInterceptor(50)(next)
+
+	start := time.Now()
+	for range 75 {
+		_, err := call(t.Context(), connect.NewRequest(&emptypb.Empty{}))
+		require.NoError(t, err)
+	}
+
+	// The first 50 requests use the burst, the next 25 wait 20ms each.
+	require.Equal(t, 75, calls)
+	require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
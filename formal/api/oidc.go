@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	formal "github.com/formalco/go-sdk/v3"
+)
+
+const (
+	// OIDCAudiencePrefix prefixes the ID of an OIDC integration in the
+	// audience of the tokens it trusts.
+	OIDCAudiencePrefix = "oidc.formal.ai/"
+
+	// The exchange is in beta: its path, form parameters and audience format
+	// follow RFC 8693 but aren't part of the published API reference yet, so
+	// check them against the API before the oidc provider block leaves beta.
+	oidcTokenExchangePath = "/oidc/token"
+
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	jwtTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+type tokenExchangeResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// ExchangeOIDCToken trades an externally signed JWT, such as a CI workload
+// identity token, for a Formal API token of the machine user trusted by the
+// given OIDC integration. The exchange follows OAuth 2.0 Token Exchange (RFC 8693).
+func ExchangeOIDCToken(ctx context.Context, httpClient *http.Client, baseURL, integrationID, token string) (string, error) {
+	if integrationID == "" {
+		return "", errors.New("OIDC integration ID must not be empty")
+	}
+	if token == "" {
+		return "", errors.New("OIDC token must not be empty")
+	}
+	if baseURL == "" {
+		baseURL = formal.FORMAL_HOST_URL
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	form := url.Values{
+		"grant_type":         {tokenExchangeGrantType},
+		"subject_token":      {token},
+		"subject_token_type": {jwtTokenType},
+		"audience":           {OIDCAudiencePrefix + integrationID},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(baseURL, "/")+oidcTokenExchangePath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("OIDC token exchange failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("OIDC token exchange failed: %w", err)
+	}
+
+	var exchanged tokenExchangeResponse
+	if err := json.Unmarshal(body, &exchanged); err != nil && res.StatusCode == http.StatusOK {
+		return "", fmt.Errorf("OIDC token exchange returned an invalid response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		if exchanged.Error != "" {
+			return "", fmt.Errorf("OIDC token exchange failed with status %d: %s: %s", res.StatusCode, exchanged.Error, exchanged.ErrorDescription)
+		}
+		return "", fmt.Errorf("OIDC token exchange failed with status %d", res.StatusCode)
+	}
+	if exchanged.AccessToken == "" {
+		return "", errors.New("OIDC token exchange returned no access token")
+	}
+
+	return exchanged.AccessToken, nil
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeTokenExchangeServer accepts exactly one subject token for one
+// integration and answers with a fixed Formal token.
+func newFakeTokenExchangeServer(t *testing.T, integrationID, subjectToken, accessToken string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != oidcTokenExchangePath {
+			http.NotFound(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("grant_type") != tokenExchangeGrantType ||
+			r.PostForm.Get("subject_token_type") != jwtTokenType ||
+			r.PostForm.Get("audience") != "oidc.formal.ai/"+integrationID ||
+			r.PostForm.Get("subject_token") != subjectToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant", "error_description": "token rejected"})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{"access_token": accessToken, "token_type": "Bearer", "expires_in": 3600})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestExchangeOIDCToken(t *testing.T) {
+	server := newFakeTokenExchangeServer(t, "integrationoidc_01abc", "ci-jwt", "formal-token")
+
+	token, err := ExchangeOIDCToken(t.Context(), server.Client(), server.URL+"/", "integrationoidc_01abc", "ci-jwt")
+	require.NoError(t, err)
+	require.Equal(t, "formal-token", token)
+}
+
+func TestExchangeOIDCTokenRejected(t *testing.T) {
+	server := newFakeTokenExchangeServer(t, "integrationoidc_01abc", "ci-jwt", "formal-token")
+
+	_, err := ExchangeOIDCToken(t.Context(), server.Client(), server.URL, "integrationoidc_01abc", "forged-jwt")
+	require.ErrorContains(t, err, "invalid_grant")
+
+	_, err = ExchangeOIDCToken(t.Context(), server.Client(), server.URL, "integrationoidc_other", "ci-jwt")
+	require.ErrorContains(t, err, "status 401")
+}
+
+func TestExchangeOIDCTokenRequiresInputs(t *testing.T) {
+	_, err := ExchangeOIDCToken(t.Context(), nil, "", "", "ci-jwt")
+	require.Error(t, err)
+
+	_, err = ExchangeOIDCToken(t.Context(), nil, "", "integrationoidc_01abc", "")
+	require.Error(t, err)
+}
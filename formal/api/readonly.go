@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// readOnlyMethodPrefixes are the prefixes of the Formal API methods that don't
+// modify anything.
+var readOnlyMethodPrefixes = []string{"Get", "List", "Search"}
+
+// newReadOnlyInterceptor rejects any unary call to a method that may modify
+// something before it is sent.
+func newReadOnlyInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			if !isReadOnlyProcedure(procedure) {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s is not allowed because the provider is in read_only mode", procedure))
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func isReadOnlyProcedure(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestIsReadOnlyProcedure(t *testing.T) {
+	for _, procedure := range []string{
+		"/core.v1.ResourceService/GetResource",
+		"/core.v1.ResourceService/ListResources",
+		"/core.v1.UserService/SearchUsers",
+	} {
+		require.True(t, isReadOnlyProcedure(procedure), procedure)
+	}
+
+	for _, procedure := range []string{
+		"/core.v1.ResourceService/CreateResource",
+		"/core.v1.ResourceService/UpdateResource",
+		"/core.v1.ResourceService/DeleteResource",
+		"/core.v1.NativeUserService/CreateNativeUserLink",
+	} {
+		require.False(t, isReadOnlyProcedure(procedure), procedure)
+	}
+}
+
+func TestReadOnlyInterceptorRejectsWrites(t *testing.T) {
+	calls := 0
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		calls++
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+
+	// Requests built with connect.NewRequest have no procedure, so they are
+	// rejected like any method that isn't a read.
+	_, err := newReadOnlyInterceptor()(next)(t.Context(), connect.NewRequest(&emptypb.Empty{}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	require.Zero(t, calls)
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries   = 5
+	DefaultRetryMaxWait = 30 * time.Second
+
+	retryBaseDelay = 500 * time.Millisecond
+)
+
+type retryPolicy struct {
+	maxRetries int
+	maxWait    time.Duration
+	baseDelay  time.Duration
+}
+
+// newRetryInterceptor retries unary calls that fail with a transient error,
+// waiting with exponential backoff and jitter between attempts. A Retry-After
+// header sent by the API takes precedence over the computed backoff.
+//
+// Calls that may modify something are only retried when the API didn't
+// process them, so that a retry can't create an object twice.
+func newRetryInterceptor(policy retryPolicy) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			for attempt := 0; ; attempt++ {
+				res, err := next(ctx, req)
+				if err == nil || attempt >= policy.maxRetries || !isRetryable(ctx, req.Spec().Procedure, err) {
+					return res, err
+				}
+
+				delay := policy.delay(attempt, err)
+				tflog.Debug(ctx, "Retrying Formal API request after transient error", map[string]any{
+					"procedure": req.Spec().Procedure,
+					"attempt":   attempt + 1,
+					"delay":     delay.String(),
+					"err":       err,
+				})
+
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, err
+				case <-timer.C:
+				}
+			}
+		}
+	}
+}
+
+func isRetryable(ctx context.Context, procedure string, err error) bool {
+	// Don't retry once the caller has given up, e.g. the resource timeout expired.
+	if ctx.Err() != nil {
+		return false
+	}
+	switch connect.CodeOf(err) {
+	case connect.CodeResourceExhausted:
+		// Rate limited requests are rejected before they are processed.
+		return true
+	case connect.CodeUnavailable, connect.CodeDeadlineExceeded:
+		// A request that timed out or lost its connection may still have been
+		// processed, which is only harmless for reads.
+		return isReadOnlyProcedure(procedure) || !requestSent(err)
+	default:
+		return false
+	}
+}
+
+// requestSent reports whether a request failing with err may have reached the
+// API, that is unless it failed to connect.
+func requestSent(err error) bool {
+	var opErr *net.OpError
+	return !errors.As(err, &opErr) || opErr.Op != "dial"
+}
+
+func (p retryPolicy) delay(attempt int, err error) time.Duration {
+	if retryAfter, ok := retryAfterFromError(err); ok {
+		return min(retryAfter, p.maxWait)
+	}
+
+	backoff := p.maxWait
+	if attempt < 32 {
+		backoff = min(p.baseDelay<<attempt, p.maxWait)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	// Equal jitter: wait at least half of the backoff so concurrent callers
+	// spread out without retrying immediately.
+	half := backoff / 2
+	return half + rand.N(backoff-half+1)
+}
+
+func retryAfterFromError(err error) (time.Duration, bool) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return 0, false
+	}
+
+	value := connectErr.Meta().Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
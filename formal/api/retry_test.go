@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func testRetryPolicy(maxRetries int) retryPolicy {
+	return retryPolicy{maxRetries: maxRetries, maxWait: 10 * time.Millisecond, baseDelay: time.Millisecond}
+}
+
+func callWithRetries(t *testing.T, policy retryPolicy, errs ...error) (int, error) {
+	t.Helper()
+
+	calls := 0
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		calls++
+		if calls <= len(errs) {
+			return nil, errs[calls-1]
+		}
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+
+	_, err := newRetryInterceptor(policy)(next)(t.Context(), connect.NewRequest(&emptypb.Empty{}))
+	return calls, err
+}
+
+// dialError is the error of a request that failed to connect to the API.
+var dialError = connect.NewError(connect.CodeUnavailable, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")})
+
+// Requests built with connect.NewRequest have no procedure, so they are
+// retried like any method that may modify something.
+func TestRetryInterceptorRetriesTransientErrors(t *testing.T) {
+	calls, err := callWithRetries(t, testRetryPolicy(3),
+		dialError,
+		connect.NewError(connect.CodeResourceExhausted, errors.New("rate limited")),
+		dialError,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 4, calls)
+}
+
+func TestRetryInterceptorStopsAfterMaxRetries(t *testing.T) {
+	calls, err := callWithRetries(t, testRetryPolicy(2), dialError, dialError, dialError, dialError)
+	require.Error(t, err)
+	require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	require.Equal(t, 3, calls)
+}
+
+func TestRetryInterceptorDoesNotRetryWritesThatMayHaveBeenProcessed(t *testing.T) {
+	calls, err := callWithRetries(t, testRetryPolicy(5), connect.NewError(connect.CodeUnavailable, errors.New("connection reset")))
+	require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	require.Equal(t, 1, calls)
+}
+
+func TestIsRetryable(t *testing.T) {
+	unavailable := connect.NewError(connect.CodeUnavailable, errors.New("connection reset"))
+	timedOut := connect.NewError(connect.CodeDeadlineExceeded, errors.New("timed out"))
+	rateLimited := connect.NewError(connect.CodeResourceExhausted, errors.New("rate limited"))
+	notFound := connect.NewError(connect.CodeNotFound, errors.New("not found"))
+
+	for _, tc := range []struct {
+		procedure string
+		err       error
+		want      bool
+	}{
+		{procedure: "/core.v1.ResourceService/GetResource", err: unavailable, want: true},
+		{procedure: "/core.v1.ResourceService/ListResources", err: timedOut, want: true},
+		{procedure: "/core.v1.ResourceService/GetResource", err: notFound, want: false},
+		{procedure: "/core.v1.ResourceService/CreateResource", err: rateLimited, want: true},
+		{procedure: "/core.v1.ResourceService/CreateResource", err: dialError, want: true},
+		{procedure: "/core.v1.ResourceService/CreateResource", err: unavailable, want: false},
+		{procedure: "/core.v1.ResourceService/UpdateResource", err: timedOut, want: false},
+	} {
+		require.Equal(t, tc.want, isRetryable(t.Context(), tc.procedure, tc.err), "%s: %v", tc.procedure, tc.err)
+	}
+}
+
+func TestRetryInterceptorDoesNotRetryPermanentErrors(t *testing.T) {
+	calls, err := callWithRetries(t, testRetryPolicy(5), connect.NewError(connect.CodeNotFound, errors.New("not found")))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.Equal(t, 1, calls)
+}
+
+func TestRetryDelayHonorsRetryAfter(t *testing.T) {
+	policy := retryPolicy{maxRetries: 5, maxWait: 30 * time.Second, baseDelay: retryBaseDelay}
+
+	err := connect.NewError(connect.CodeResourceExhausted, errors.New("rate limited"))
+	err.Meta().Set("Retry-After", "7")
+	require.Equal(t, 7*time.Second, policy.delay(0, err))
+
+	err.Meta().Set("Retry-After", "120")
+	require.Equal(t, 30*time.Second, policy.delay(0, err), "Retry-After must be capped by retry_max_wait")
+}
+
+func TestRetryDelayBacksOffExponentially(t *testing.T) {
+	policy := retryPolicy{maxRetries: 10, maxWait: 4 * time.Second, baseDelay: retryBaseDelay}
+	err := connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
+
+	for attempt, want := range []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		got := policy.delay(attempt, err)
+		require.GreaterOrEqual(t, got, want/2)
+		require.LessOrEqual(t, got, want)
+	}
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// LogSubsystem is the tflog subsystem of Formal API requests. Its level is
+	// set with TF_LOG_PROVIDER_FORMAL_API.
+	LogSubsystem = "formal_api"
+
+	requestIDHeader = "X-Request-Id"
+	redactedValue   = "REDACTED"
+)
+
+// sensitiveFieldNames are redacted from logged messages, along with any field
+// ending with one of sensitiveFieldSuffixes or marked with debug_redact.
+var (
+	sensitiveFieldNames = map[string]bool{
+		"api_key":            true,
+		"secret":             true,
+		"password":           true,
+		"token":              true,
+		"native_user_secret": true,
+		"tls_client_key":     true,
+		"private_key":        true,
+	}
+	sensitiveFieldSuffixes = []string{"_secret", "_password", "_token", "_api_key", "_private_key"}
+)
+
+// newTracingInterceptor logs each unary call with its procedure, duration,
+// status code and request ID. With logBodies, the request and response
+// messages are also logged as JSON with sensitive fields redacted.
+func newTracingInterceptor(logBodies bool) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_FORMAL_API"), tflog.WithRootFields())
+
+			procedure := req.Spec().Procedure
+			requestFields := map[string]any{"procedure": procedure}
+			if logBodies {
+				requestFields["request_body"] = redactedJSON(req.Any())
+			}
+			tflog.SubsystemTrace(ctx, LogSubsystem, "Sending Formal API request", requestFields)
+
+			start := time.Now()
+			res, err := next(ctx, req)
+			fields := map[string]any{
+				"procedure":   procedure,
+				"duration_ms": time.Since(start).Milliseconds(),
+			}
+
+			if err != nil {
+				fields["code"] = connect.CodeOf(err).String()
+				fields["error"] = err.Error()
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) {
+					if requestID := connectErr.Meta().Get(requestIDHeader); requestID != "" {
+						fields["request_id"] = requestID
+					}
+				}
+				tflog.SubsystemDebug(ctx, LogSubsystem, "Formal API request failed", fields)
+				return res, err
+			}
+
+			fields["code"] = "ok"
+			if requestID := res.Header().Get(requestIDHeader); requestID != "" {
+				fields["request_id"] = requestID
+			}
+			if logBodies {
+				fields["response_body"] = redactedJSON(res.Any())
+			}
+			tflog.SubsystemDebug(ctx, LogSubsystem, "Received Formal API response", fields)
+			return res, err
+		}
+	}
+}
+
+func redactedJSON(msg any) string {
+	m, ok := msg.(proto.Message)
+	if !ok || m == nil {
+		return ""
+	}
+	m = proto.Clone(m)
+	redact(m.ProtoReflect())
+
+	body, err := protojson.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	return string(body)
+}
+
+// redact replaces the value of sensitive string and bytes fields of m, and of
+// the messages it contains.
+func redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redact(mv.Message())
+					return true
+				})
+			} else if isSensitiveField(fd) {
+				m.Clear(fd)
+			}
+		case fd.Message() != nil:
+			if fd.IsList() {
+				for i := range v.List().Len() {
+					redact(v.List().Get(i).Message())
+				}
+			} else {
+				redact(v.Message())
+			}
+		case isSensitiveField(fd) && (fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.BytesKind):
+			if fd.IsList() {
+				m.Clear(fd)
+				return true
+			}
+			if fd.Kind() == protoreflect.StringKind {
+				m.Set(fd, protoreflect.ValueOfString(redactedValue))
+			} else {
+				m.Set(fd, protoreflect.ValueOfBytes([]byte(redactedValue)))
+			}
+		}
+		return true
+	})
+}
+
+func isSensitiveField(fd protoreflect.FieldDescriptor) bool {
+	if opts, ok := fd.Options().(*descriptorpb.FieldOptions); ok && opts.GetDebugRedact() {
+		return true
+	}
+
+	name := string(fd.Name())
+	if sensitiveFieldNames[name] {
+		return true
+	}
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
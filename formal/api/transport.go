@@ -0,0 +1,68 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportConfig customizes how the provider connects to the Formal API, for
+// example through a TLS-intercepting egress proxy.
+type TransportConfig struct {
+	// CACertPEM is a PEM bundle of certificate authorities trusted in
+	// addition to the system pool.
+	CACertPEM string
+	// ClientCertPEM and ClientKeyPEM are presented for mutual TLS.
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// ProxyURL overrides the HTTPS_PROXY and HTTP_PROXY environment variables.
+	ProxyURL           string
+	InsecureSkipVerify bool
+}
+
+// NewHTTPClient returns an HTTP client for the Formal API honoring cfg. It is
+// used both by the SDK and by the OIDC token exchange.
+func NewHTTPClient(cfg TransportConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, errors.New("ca_cert_pem does not contain any valid PEM certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertPEM != "" || cfg.ClientKeyPEM != "" {
+		if cfg.ClientCertPEM == "" || cfg.ClientKeyPEM == "" {
+			return nil, errors.New("client_cert_pem and client_key_pem must be set together")
+		}
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url %q", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func certificatePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+// newClientCertificate returns a self-signed client certificate and its key, PEM encoded.
+func newClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-formal"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return cert, certificatePEM(cert), string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+func get(t *testing.T, cfg TransportConfig, url string) error {
+	t.Helper()
+
+	client, err := NewHTTPClient(cfg)
+	require.NoError(t, err)
+
+	res, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	return nil
+}
+
+func TestHTTPClientTrustsCustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	require.Error(t, get(t, TransportConfig{}, server.URL), "the test server certificate must not be trusted by default")
+	require.NoError(t, get(t, TransportConfig{CACertPEM: certificatePEM(server.Certificate())}, server.URL))
+	require.NoError(t, get(t, TransportConfig{InsecureSkipVerify: true}, server.URL))
+}
+
+func TestHTTPClientPresentsClientCertificate(t *testing.T) {
+	clientCert, clientCertPEM, clientKeyPEM := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	caCertPEM := certificatePEM(server.Certificate())
+	require.Error(t, get(t, TransportConfig{CACertPEM: caCertPEM}, server.URL))
+	require.NoError(t, get(t, TransportConfig{CACertPEM: caCertPEM, ClientCertPEM: clientCertPEM, ClientKeyPEM: clientKeyPEM}, server.URL))
+}
+
+func TestHTTPClientUsesProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	require.NoError(t, get(t, TransportConfig{ProxyURL: proxy.URL}, "http://formal-api.example.com/ping"))
+	require.Equal(t, "http://formal-api.example.com/ping", proxied)
+}
+
+func TestHTTPClientRejectsInvalidConfig(t *testing.T) {
+	_, clientCertPEM, clientKeyPEM := newClientCertificate(t)
+
+	for name, cfg := range map[string]TransportConfig{
+		"invalid CA":               {CACertPEM: "not a certificate"},
+		"certificate without key":  {ClientCertPEM: clientCertPEM},
+		"key without certificate":  {ClientKeyPEM: clientKeyPEM},
+		"mismatched key pair":      {ClientCertPEM: clientKeyPEM, ClientKeyPEM: clientCertPEM},
+		"proxy URL without scheme": {ProxyURL: "proxy.example.com:3128"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewHTTPClient(cfg)
+			require.Error(t, err)
+		})
+	}
+}
@@ -6,4 +6,10 @@ import (
 
 type Clients struct {
 	Grpc *api.GrpcClient
+	// DefaultTags are merged into the tags of every taggable resource.
+	DefaultTags map[string]string
+	// DefaultSpaceID is the Space of resources without a space_id.
+	DefaultSpaceID string
+	// ReadOnly makes Create, Update and Delete of every resource fail.
+	ReadOnly bool
 }
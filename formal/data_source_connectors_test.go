@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorsDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Connector", fmt.Sprintf(`{"name": %q}`, name+"-a"))
+	otherID := testAccPut(t, server, "core.v1.Connector", fmt.Sprintf(`{"name": %q, "terminationProtection": true}`, name+"-b"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorsDataSourceConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_connectors.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.formal_connectors.test", "ids.0", id),
+					resource.TestCheckResourceAttr("data.formal_connectors.test", "ids.1", otherID),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorsDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "name"
+    value = %q
+  }`, name+"-a")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_connectors.test", "connectors.#", "1"),
+					resource.TestCheckResourceAttr("data.formal_connectors.test", "connectors.0.id", id),
+					resource.TestCheckResourceAttr("data.formal_connectors.test", "connectors.0.name", name+"-a"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorsDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "name"
+    value = %q
+  }
+  filter {
+    key   = "termination_protection"
+    value = "true"
+  }`, name+"-b")),
+				Check: resource.TestCheckResourceAttr("data.formal_connectors.test", "connectors.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccConnectorsDataSourceConfig(filters string) string {
+	return fmt.Sprintf(`
+data "formal_connectors" "test" {%s
+}
+`, filters)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccEncryptionKeyDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	id := testAccPut(t, server, "core.v1.EncryptionKey", `{
+		"provider": "aws-kms",
+		"keyId": "arn:aws:kms:us-east-1:123456789012:key/payments",
+		"algorithm": "rsaes_oaep_sha256",
+		"createdAt": "2025-01-02T03:04:05Z",
+		"updatedAt": "2025-02-03T04:05:06Z"
+	}`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_encryption_key" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_encryption_key.test", "key_provider", "aws-kms"),
+					resource.TestCheckResourceAttr("data.formal_encryption_key.test", "key_id", "arn:aws:kms:us-east-1:123456789012:key/payments"),
+					resource.TestCheckResourceAttr("data.formal_encryption_key.test", "algorithm", "rsaes_oaep_sha256"),
+					resource.TestCheckResourceAttr("data.formal_encryption_key.test", "created_at", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.formal_encryption_key.test", "updated_at", "2025-02-03T04:05:06Z"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccFormDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Form", fmt.Sprintf(`{
+		"name": %q,
+		"description": "Access request",
+		"fields": [
+			{"id": "reason", "name": "Reason", "type": "string"},
+			{"id": "access", "name": "Access", "type": "select", "config": {"options": [{"label": "Read", "value": "read"}]}}
+		],
+		"createdAt": "2025-01-02T03:04:05Z",
+		"updatedAt": "2025-02-03T04:05:06Z"
+	}`, name))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_form" "test" {
+  name = %q
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_form.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_form.test", "description", "Access request"),
+					resource.TestCheckResourceAttr("data.formal_form.test", "field.#", "2"),
+					resource.TestCheckResourceAttr("data.formal_form.test", "field.0.id", "reason"),
+					resource.TestCheckResourceAttr("data.formal_form.test", "field.1.type", "select"),
+					resource.TestCheckResourceAttr("data.formal_form.test", "created_at", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.formal_form.test", "updated_at", "2025-02-03T04:05:06Z"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_form" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.TestCheckResourceAttr("data.formal_form.test", "name", name),
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccGroupMembersDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	groupID := testAccPut(t, server, "core.v1.Group", fmt.Sprintf(`{"name": %q}`, name))
+	otherGroupID := testAccPut(t, server, "core.v1.Group", fmt.Sprintf(`{"name": %q}`, name+"-other"))
+	email := name + "@example.com"
+	humanID := testAccPut(t, server, "core.v1.User", fmt.Sprintf(`{"type": "human", "dbUsername": %q, "human": {"email": %q}}`, "idp:formal:human:"+email, email))
+	machineID := testAccPut(t, server, "core.v1.User", `{"type": "machine", "dbUsername": "idp:formal:machine:tf-acc-machine"}`)
+	linkID := testAccPut(t, server, "core.v1.UserGroupLink", fmt.Sprintf(`{"group": {"id": %q}, "user": {"id": %q, "type": "human", "dbUsername": %q, "human": {"email": %q}}}`, groupID, humanID, "idp:formal:human:"+email, email))
+	testAccPut(t, server, "core.v1.UserGroupLink", fmt.Sprintf(`{"group": {"id": %q}, "user": {"id": %q, "type": "machine"}}`, groupID, machineID))
+	testAccPut(t, server, "core.v1.UserGroupLink", fmt.Sprintf(`{"group": {"id": %q}, "user": {"id": %q, "type": "machine"}}`, otherGroupID, machineID))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_group_members" "test" {
+  group_id = %q
+}
+`, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_group_members.test", "user_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.formal_group_members.test", "user_ids.0", humanID),
+					resource.TestCheckResourceAttr("data.formal_group_members.test", "user_ids.1", machineID),
+					resource.TestCheckResourceAttr("data.formal_group_members.test", "users.0.link_id", linkID),
+					resource.TestCheckResourceAttr("data.formal_group_members.test", "users.0.email", email),
+					resource.TestCheckResourceAttr("data.formal_group_members.test", "users.1.type", "machine"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccGroupsDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Group", fmt.Sprintf(`{"name": %q, "description": "Reviewers"}`, name+"-a"))
+	otherID := testAccPut(t, server, "core.v1.Group", fmt.Sprintf(`{"name": %q, "description": "Approvers"}`, name+"-b"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccGroupsDataSourceConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_groups.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.formal_groups.test", "ids.0", id),
+					resource.TestCheckResourceAttr("data.formal_groups.test", "ids.1", otherID),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccGroupsDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "name"
+    value = %q
+  }`, name+"-a")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_groups.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.formal_groups.test", "groups.0.id", id),
+					resource.TestCheckResourceAttr("data.formal_groups.test", "groups.0.name", name+"-a"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccGroupsDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "name"
+    value = %q
+  }
+  filter {
+    key   = "description"
+    value = "Reviewers"
+  }`, name+"-b")),
+				Check: resource.TestCheckResourceAttr("data.formal_groups.test", "groups.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccGroupsDataSourceConfig(filters string) string {
+	return fmt.Sprintf(`
+data "formal_groups" "test" {%s
+}
+`, filters)
+}
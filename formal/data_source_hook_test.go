@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccHookDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	id := testAccPut(t, server, "core.v1.Hook", `{
+		"name": "tf_acc_risk_score",
+		"description": "Scores the request",
+		"code": "export default function hook(input) { return { score: 1 }; }",
+		"status": "active",
+		"timeoutMs": 2000,
+		"allowlistedEnvironmentVariables": ["RISK_API_KEY"],
+		"allowlistedNetworkHosts": ["risk.example.com"],
+		"createdAt": "2025-01-02T03:04:05Z",
+		"updatedAt": "2025-02-03T04:05:06Z"
+	}`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "formal_hook" "test" {
+  name = "tf_acc_risk_score"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_hook.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_hook.test", "description", "Scores the request"),
+					resource.TestCheckResourceAttr("data.formal_hook.test", "code", "export default function hook(input) { return { score: 1 }; }"),
+					resource.TestCheckResourceAttr("data.formal_hook.test", "status", "active"),
+					resource.TestCheckResourceAttr("data.formal_hook.test", "timeout_ms", "2000"),
+					resource.TestCheckTypeSetElemAttr("data.formal_hook.test", "allowlisted_environment_variables.*", "RISK_API_KEY"),
+					resource.TestCheckTypeSetElemAttr("data.formal_hook.test", "allowlisted_network_hosts.*", "risk.example.com"),
+					resource.TestCheckResourceAttr("data.formal_hook.test", "created_at", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.formal_hook.test", "updated_at", "2025-02-03T04:05:06Z"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_hook" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.TestCheckResourceAttr("data.formal_hook.test", "name", "tf_acc_risk_score"),
+			},
+		},
+	})
+}
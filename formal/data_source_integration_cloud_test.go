@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccIntegrationCloudDataSource_gcp(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.CloudIntegration", fmt.Sprintf(`{
+		"name": %q,
+		"gcp": {
+			"gcpProjectId": "payments-prod",
+			"gcpServiceAccountEmail": "formal@payments-prod.iam.gserviceaccount.com",
+			"gcpWorkloadIdentityPoolProvider": "projects/1/locations/global/workloadIdentityPools/formal/providers/aws",
+			"awsFormalRoleArn": "arn:aws:iam::123456789012:role/formal-gcp",
+			"gcpPermissions": ["storage.objects.create"]
+		}
+	}`, name))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_integration_cloud" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_integration_cloud.test", "name", name),
+					resource.TestCheckResourceAttr("data.formal_integration_cloud.test", "type", "gcp"),
+					resource.TestCheckResourceAttr("data.formal_integration_cloud.test", "gcp_project_id", "payments-prod"),
+					resource.TestCheckResourceAttr("data.formal_integration_cloud.test", "gcp_service_account_email", "formal@payments-prod.iam.gserviceaccount.com"),
+					resource.TestCheckResourceAttr("data.formal_integration_cloud.test", "gcp_workload_identity_pool_provider", "projects/1/locations/global/workloadIdentityPools/formal/providers/aws"),
+					resource.TestCheckResourceAttr("data.formal_integration_cloud.test", "aws_formal_role_arn", "arn:aws:iam::123456789012:role/formal-gcp"),
+					resource.TestCheckResourceAttr("data.formal_integration_cloud.test", "gcp_permissions.#", "1"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_integration_cloud" "test" {
+  name = %q
+}
+`, name),
+				Check: resource.TestCheckResourceAttr("data.formal_integration_cloud.test", "id", id),
+			},
+		},
+	})
+}
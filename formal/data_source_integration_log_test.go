@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccIntegrationLogDataSource_gcs(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.IntegrationLog", fmt.Sprintf(`{
+		"name": %q,
+		"gcs": {
+			"cloudIntegrationId": "integration_cloud_logs",
+			"bucketName": "tf-acc-logs",
+			"bucketPrefix": "audit",
+			"compression": "LOG_COMPRESSION_GZIP"
+		}
+	}`, name))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_integration_log" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_integration_log.test", "name", name),
+					resource.TestCheckResourceAttr("data.formal_integration_log.test", "aws_s3.#", "0"),
+					resource.TestCheckResourceAttr("data.formal_integration_log.test", "gcs.#", "1"),
+					resource.TestCheckResourceAttr("data.formal_integration_log.test", "gcs.0.gcs_bucket_name", "tf-acc-logs"),
+					resource.TestCheckResourceAttr("data.formal_integration_log.test", "gcs.0.gcs_bucket_prefix", "audit"),
+					resource.TestCheckResourceAttr("data.formal_integration_log.test", "gcs.0.compression", "gzip"),
+					resource.TestCheckResourceAttr("data.formal_integration_log.test", "gcs.0.cloud_integration_id", "integration_cloud_logs"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_integration_log" "test" {
+  name = %q
+}
+`, name),
+				Check: resource.TestCheckResourceAttr("data.formal_integration_log.test", "id", id),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccNativeUserDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	id := testAccPut(t, server, "core.v1.NativeUser", `{"resourceId": "resource_payments", "username": "payments_reader", "useAsDefault": true}`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_native_user" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_native_user.test", "resource_id", "resource_payments"),
+					resource.TestCheckResourceAttr("data.formal_native_user.test", "native_user_id", "payments_reader"),
+					resource.TestCheckResourceAttr("data.formal_native_user.test", "use_as_default", "true"),
+					resource.TestCheckResourceAttr("data.formal_native_user.test", "termination_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNativeUserDataSource_username(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	testAccPut(t, server, "core.v1.NativeUser", `{"resourceId": "resource_orders", "username": "reader"}`)
+	id := testAccPut(t, server, "core.v1.NativeUser", `{"resourceId": "resource_payments", "username": "reader", "useAsDefault": true}`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "formal_native_user" "test" {
+  native_user_id = "reader"
+  resource_id    = "resource_payments"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_native_user.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_native_user.test", "use_as_default", "true"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "formal_native_user" "test" {
+  native_user_id = "writer"
+}
+`,
+				ExpectError: regexp.MustCompile("no native user found with username writer"),
+			},
+		},
+	})
+}
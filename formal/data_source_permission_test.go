@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccPermissionDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Permission", fmt.Sprintf(`{
+		"name": %q,
+		"description": "Read access to payments",
+		"code": %q,
+		"status": "dry-run",
+		"createdAt": "2025-01-02T03:04:05Z",
+		"updatedAt": "2025-02-03T04:05:06Z"
+	}`, name, testAccPermissionCode("allow")))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_permission" "test" {
+  name = %q
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_permission.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_permission.test", "description", "Read access to payments"),
+					resource.TestCheckResourceAttr("data.formal_permission.test", "code", testAccPermissionCode("allow")),
+					resource.TestCheckResourceAttr("data.formal_permission.test", "status", "dry-run"),
+					resource.TestCheckResourceAttr("data.formal_permission.test", "termination_protection", "false"),
+					resource.TestCheckResourceAttr("data.formal_permission.test", "created_at", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.formal_permission.test", "updated_at", "2025-02-03T04:05:06Z"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_permission" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.TestCheckResourceAttr("data.formal_permission.test", "name", name),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "formal_permission" "test" {
+  id = "permission_missing"
+}
+`,
+				ExpectError: regexp.MustCompile("no permission found with id permission_missing"),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccPolicyDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Policy", fmt.Sprintf(`{
+		"name": %q,
+		"description": "Shared masking rules",
+		"code": %q,
+		"status": "active",
+		"terminationProtection": true,
+		"createdAt": "2025-01-02T03:04:05Z",
+		"updatedAt": "2025-02-03T04:05:06Z"
+	}`, name, testAccPolicyCode("mask")))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_policy" "test" {
+  name = %q
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_policy.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_policy.test", "description", "Shared masking rules"),
+					resource.TestCheckResourceAttr("data.formal_policy.test", "module", testAccPolicyCode("mask")),
+					resource.TestCheckResourceAttr("data.formal_policy.test", "status", "active"),
+					resource.TestCheckResourceAttr("data.formal_policy.test", "termination_protection", "true"),
+					resource.TestCheckResourceAttr("data.formal_policy.test", "created_at", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.formal_policy.test", "updated_at", "2025-02-03T04:05:06Z"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_policy" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.TestCheckResourceAttr("data.formal_policy.test", "name", name),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "formal_policy" "test" {
+  name = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile("no policy found with name missing"),
+			},
+		},
+	})
+}
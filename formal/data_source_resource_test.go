@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccResourceDataSource_tags(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Resource", fmt.Sprintf(`{
+		"name": %q,
+		"technology": "postgres",
+		"hostname": "payments.example.com",
+		"port": 5432,
+		"aliases": ["payments-db"],
+		"tags": [{"key": "env", "value": "prod"}, {"key": "team", "value": "payments"}]
+	}`, name+"-payments"))
+	testAccPut(t, server, "core.v1.Resource", fmt.Sprintf(`{
+		"name": %q,
+		"technology": "postgres",
+		"hostname": "billing.example.com",
+		"port": 5432,
+		"tags": [{"key": "env", "value": "prod"}, {"key": "team", "value": "billing"}]
+	}`, name+"-billing"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "formal_resource" "test" {
+  filter_tags = {
+    env  = "prod"
+    team = "payments"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_resource.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_resource.test", "name", name+"-payments"),
+					resource.TestCheckResourceAttr("data.formal_resource.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("data.formal_resource.test", "aliases.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.formal_resource.test", "aliases.*", "payments-db"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_resource" "test" {
+  name = %q
+}
+`, name+"-billing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_resource.test", "tags.env", "prod"),
+					resource.TestCheckResourceAttr("data.formal_resource.test", "tags.team", "billing"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "formal_resource" "test" {
+  filter_tags = {
+    env = "prod"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("2 resources found with tags"),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "formal_resource" "test" {
+  filter_tags = {
+    env = "staging"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("no resource found with tags"),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccResourcesDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Resource", fmt.Sprintf(`{"name": %q, "technology": "postgres", "hostname": "postgres.example.com", "port": 5432}`, name+"-a"))
+	otherID := testAccPut(t, server, "core.v1.Resource", fmt.Sprintf(`{"name": %q, "technology": "mysql", "hostname": "mysql.example.com", "port": 3306}`, name+"-b"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccResourcesDataSourceConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_resources.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.formal_resources.test", "ids.0", id),
+					resource.TestCheckResourceAttr("data.formal_resources.test", "ids.1", otherID),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourcesDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "name"
+    value = %q
+  }`, name+"-a")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_resources.test", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.formal_resources.test", "resources.0.id", id),
+					resource.TestCheckResourceAttr("data.formal_resources.test", "resources.0.name", name+"-a"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourcesDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "name"
+    value = %q
+  }
+  filter {
+    key   = "technology"
+    value = "postgres"
+  }`, name+"-b")),
+				Check: resource.TestCheckResourceAttr("data.formal_resources.test", "resources.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig(filters string) string {
+	return fmt.Sprintf(`
+data "formal_resources" "test" {%s
+}
+`, filters)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccSatelliteDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Satellite", fmt.Sprintf(`{"name": %q, "satelliteType": "ai", "terminationProtection": true}`, name))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_satellite" "test" {
+  name = %q
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_satellite.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_satellite.test", "satellite_type", "ai"),
+					resource.TestCheckResourceAttr("data.formal_satellite.test", "termination_protection", "true"),
+					resource.TestCheckNoResourceAttr("data.formal_satellite.test", "api_key"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "formal" {
+  api_key                   = %q
+  base_url                  = %q
+  retrieve_sensitive_values = true
+}
+
+data "formal_satellite" "test" {
+  id = %q
+}
+`, fakeapi.APIKey, server.URL, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_satellite.test", "name", name),
+					// The fake API returns placeholders for derived values.
+					resource.TestCheckResourceAttr("data.formal_satellite.test", "api_key", "fake-api-key"),
+				),
+			},
+		},
+	})
+}
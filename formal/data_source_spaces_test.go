@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccSpacesDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	id := testAccPut(t, server, "core.v1.Space", fmt.Sprintf(`{"name": %q, "description": "Production"}`, name+"-a"))
+	otherID := testAccPut(t, server, "core.v1.Space", fmt.Sprintf(`{"name": %q, "description": "Staging"}`, name+"-b"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccSpacesDataSourceConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_spaces.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.formal_spaces.test", "ids.0", id),
+					resource.TestCheckResourceAttr("data.formal_spaces.test", "ids.1", otherID),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccSpacesDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "name"
+    value = %q
+  }`, name+"-a")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_spaces.test", "spaces.#", "1"),
+					resource.TestCheckResourceAttr("data.formal_spaces.test", "spaces.0.id", id),
+					resource.TestCheckResourceAttr("data.formal_spaces.test", "spaces.0.name", name+"-a"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccSpacesDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "name"
+    value = %q
+  }
+  filter {
+    key   = "description"
+    value = "Production"
+  }`, name+"-b")),
+				Check: resource.TestCheckResourceAttr("data.formal_spaces.test", "spaces.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccSpacesDataSourceConfig(filters string) string {
+	return fmt.Sprintf(`
+data "formal_spaces" "test" {%s
+}
+`, filters)
+}
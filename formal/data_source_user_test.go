@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func TestAccUserDataSource_email(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	email := name + "@example.com"
+	groupID := testAccPut(t, server, "core.v1.Group", fmt.Sprintf(`{"name": %q, "description": "Reviewers"}`, name))
+	id := testAccPut(t, server, "core.v1.User", fmt.Sprintf(`{
+		"type": "human",
+		"dbUsername": %q,
+		"groupIds": [%q],
+		"human": {"firstName": "Jane", "lastName": "Doe", "email": %q}
+	}`, "idp:formal:human:"+email, groupID, email))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_user" "test" {
+  email = %q
+}
+`, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_user.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_user.test", "first_name", "Jane"),
+					resource.TestCheckResourceAttr("data.formal_user.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.formal_user.test", "groups.0.id", groupID),
+					resource.TestCheckResourceAttr("data.formal_user.test", "groups.0.name", name),
+					resource.TestCheckResourceAttr("data.formal_user.test", "groups.0.description", "Reviewers"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_user" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.TestCheckResourceAttr("data.formal_user.test", "email", email),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "formal_user" "test" {
+  email = "missing@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile("no user found with email missing@example.com"),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_groups(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	var groupIDs []string
+	for range paging.PageSize + 1 {
+		groupIDs = append(groupIDs, testAccPut(t, server, "core.v1.Group", fmt.Sprintf(`{"name": %q}`, testAccName())))
+	}
+	first, last := groupIDs[0], groupIDs[len(groupIDs)-1]
+	id := testAccPut(t, server, "core.v1.User", fmt.Sprintf(`{"type": "machine", "groupIds": [%q, %q]}`, last, first))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_user" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_user.test", "groups.#", "2"),
+					resource.TestCheckResourceAttr("data.formal_user.test", "groups.0.id", last),
+					resource.TestCheckResourceAttr("data.formal_user.test", "groups.1.id", first),
+					func(*terraform.State) error {
+						if slices.Contains(server.Calls(), "/core.v1.GroupService/GetGroup") {
+							return errors.New("the groups of the user were read one by one")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccUsersDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	email := testAccName() + "@example.com"
+	id := testAccPut(t, server, "core.v1.User", fmt.Sprintf(`{"type": "human", "dbUsername": %q, "human": {"firstName": "Jane", "lastName": "Doe", "email": %q}}`, "idp:formal:human:"+email, email))
+	otherID := testAccPut(t, server, "core.v1.User", `{"type": "machine", "dbUsername": "idp:formal:machine:tf-acc-machine"}`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccUsersDataSourceConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_users.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.formal_users.test", "ids.0", id),
+					resource.TestCheckResourceAttr("data.formal_users.test", "ids.1", otherID),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccUsersDataSourceConfig(`
+  filter {
+    key   = "type"
+    value = "human"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.formal_users.test", "users.0.id", id),
+					resource.TestCheckResourceAttr("data.formal_users.test", "users.0.first_name", "Jane"),
+					resource.TestCheckResourceAttr("data.formal_users.test", "users.0.email", email),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccUsersDataSourceConfig(fmt.Sprintf(`
+  filter {
+    key   = "type"
+    value = "machine"
+  }
+  filter {
+    key   = "db_username"
+    value = %q
+  }`, "idp:formal:human:"+email)),
+				Check: resource.TestCheckResourceAttr("data.formal_users.test", "users.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig(filters string) string {
+	return fmt.Sprintf(`
+data "formal_users" "test" {%s
+}
+`, filters)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccWorkflowDataSource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	code := fmt.Sprintf("name: %s\ntrigger:\n  type: manual\n", name)
+	id := testAccPut(t, server, "core.v1.Workflow", fmt.Sprintf(`{
+		"name": %q,
+		"code": %q,
+		"status": "draft",
+		"createdAt": "2025-01-02T03:04:05Z",
+		"updatedAt": "2025-02-03T04:05:06Z"
+	}`, name, code))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_workflow" "test" {
+  name = %q
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.formal_workflow.test", "id", id),
+					resource.TestCheckResourceAttr("data.formal_workflow.test", "code", code),
+					resource.TestCheckResourceAttr("data.formal_workflow.test", "status", "draft"),
+					resource.TestCheckResourceAttr("data.formal_workflow.test", "created_at", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.formal_workflow.test", "updated_at", "2025-02-03T04:05:06Z"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+data "formal_workflow" "test" {
+  id = %q
+}
+`, id),
+				Check: resource.TestCheckResourceAttr("data.formal_workflow.test", "name", name),
+			},
+		},
+	})
+}
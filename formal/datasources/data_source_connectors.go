@@ -0,0 +1,101 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func Connectors() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing the Connectors matching a set of filters. Unlike `formal_connector`, it doesn't return the Connectors' API keys.",
+		ReadContext: connectorsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema("Connectors"),
+			"ids": {
+				Description: "The IDs of the matching Connectors.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"connectors": {
+				Description: "The matching Connectors.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of this Connector.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Connector.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"space_id": {
+							Description: "The ID of the Space the Connector is in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"termination_protection": {
+							Description: "If set to true, this Connector cannot be deleted.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func connectorsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	filters, err := expandFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	connectors, err := listAll(ctx, filters, (*corev1.Connector).GetId, func(ctx context.Context, filter *corev1.Filter, cursor string) ([]*corev1.Connector, string, error) {
+		res, err := c.Grpc.Sdk.ConnectorServiceClient.ListConnectors(ctx, &corev1.ListConnectorsRequest{
+			Filter: filter,
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Connectors, res.NextCursor, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(connectors))
+	items := make([]map[string]any, 0, len(connectors))
+	for _, connector := range connectors {
+		item := map[string]any{
+			"id":                     connector.Id,
+			"name":                   connector.Name,
+			"termination_protection": connector.TerminationProtection,
+		}
+		if connector.Space != nil {
+			item["space_id"] = connector.Space.Id
+		}
+		ids = append(ids, connector.Id)
+		items = append(items, item)
+	}
+
+	d.SetId(listID(ids))
+	d.Set("ids", ids)
+	d.Set("connectors", items)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func EncryptionKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up an Encryption Key by ID.",
+		ReadContext: encryptionKeyRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the encryption key to look up.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"key_provider": {
+				Description: "The provider of the encryption key: `aws-kms`, `gcp-kms` or `azure-key-vault`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"key_id": {
+				Description: "The ID of the key in the provider's system.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"algorithm": {
+				Description: "The algorithm of the encryption key.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"decryptor_uri": {
+				Description: "The URI of the decryptor used to decrypt the data on the frontend.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"public_key_pem": {
+				Description: "PEM-encoded RSA public key for client-side encryption.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "When the encryption key was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last update time.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func encryptionKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	id := d.Get("id").(string)
+
+	res, err := c.Grpc.Sdk.LogsServiceClient.GetEncryptionKey(ctx, &corev1.GetEncryptionKeyRequest{Id: id})
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return diag.Errorf("no encryption key found with id %s", id)
+		}
+		return diag.FromErr(err)
+	}
+
+	key := res.EncryptionKey
+	d.SetId(key.Id)
+	d.Set("key_provider", key.Provider)
+	d.Set("key_id", key.KeyId)
+	d.Set("algorithm", key.Algorithm)
+	d.Set("decryptor_uri", key.DecryptorUri)
+	d.Set("public_key_pem", key.PublicKeyPem)
+	if key.CreatedAt != nil {
+		d.Set("created_at", key.CreatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+	if key.UpdatedAt != nil {
+		d.Set("updated_at", key.UpdatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func Form() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Form by ID or by name. Use either `id` or `name`, but not both.",
+		ReadContext: formRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of this Form.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the Form to look up. Use this to fetch a form by name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"description": {
+				Description: "Description of this Form.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"field": {
+				Description: "The fields of this Form.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Unique field identifier.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Display name of the field.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Field type.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Description: "When the form was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last update time.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func formRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var form *corev1.Form
+
+	if formID, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.WorkflowServiceClient.GetForm(ctx, &corev1.GetFormRequest{Id: formID.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no form found with id %s", formID)
+			}
+			return diag.FromErr(err)
+		}
+		form = res.Form
+	} else {
+		name := d.Get("name").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res, err := c.Grpc.Sdk.WorkflowServiceClient.ListForms(ctx, &corev1.ListFormsRequest{
+			Filter: &corev1.Filter{
+				Field: &corev1.Field{
+					Key:      "name",
+					Operator: "equals",
+					Value:    filterValue,
+				},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(res.Forms) == 0 {
+			return diag.Errorf("no form found with name %s", name)
+		}
+		form = res.Forms[0]
+	}
+
+	fields := make([]map[string]any, 0, len(form.Fields))
+	for _, field := range form.Fields {
+		fields = append(fields, map[string]any{
+			"id":   field.Id,
+			"name": field.Name,
+			"type": field.Type,
+		})
+	}
+
+	d.SetId(form.Id)
+	d.Set("name", form.Name)
+	d.Set("description", form.Description)
+	d.Set("field", fields)
+	if form.CreatedAt != nil {
+		d.Set("created_at", form.CreatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+	if form.UpdatedAt != nil {
+		d.Set("updated_at", form.UpdatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func GroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing the Users in a Group.",
+		ReadContext: groupMembersRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The ID of the Group to list the Users of.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"user_ids": {
+				Description: "The IDs of the Users in the Group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Description: "The Users in the Group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the User.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"link_id": {
+							Description: "The ID of the link between the User and the Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the User, either `human` or `machine`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"db_username": {
+							Description: "The identity of the User.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"full_name": {
+							Description: "The full name of the User.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "The email of the User. Only set for human users.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func groupMembersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	groupID := d.Get("group_id").(string)
+
+	links, err := paging.Collect(ctx, func(ctx context.Context, cursor string) ([]*corev1.UserGroupLink, string, error) {
+		res, err := c.Grpc.Sdk.GroupServiceClient.ListUserGroupLinks(ctx, &corev1.ListUserGroupLinksRequest{
+			GroupId: groupID,
+			Limit:   paging.PageSize,
+			Cursor:  cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.UserGroupLinks, res.NextCursor, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userIDs := make([]string, 0, len(links))
+	users := make([]map[string]any, 0, len(links))
+	for _, link := range links {
+		if link.Group.GetId() != groupID || link.User == nil {
+			continue
+		}
+		user := map[string]any{
+			"id":          link.User.Id,
+			"link_id":     link.Id,
+			"type":        link.User.Type,
+			"db_username": link.User.DbUsername,
+			"full_name":   link.User.FullName,
+		}
+		if human := link.User.GetHuman(); human != nil {
+			user["email"] = human.Email
+		}
+		userIDs = append(userIDs, link.User.Id)
+		users = append(users, user)
+	}
+
+	d.SetId(groupID)
+	d.Set("user_ids", userIDs)
+	d.Set("users", users)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func Groups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing the Groups matching a set of filters.",
+		ReadContext: groupsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema("Groups"),
+			"ids": {
+				Description: "The IDs of the matching Groups.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Description: "The matching Groups.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of this Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description for this Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"termination_protection": {
+							Description: "If set to true, this Group cannot be deleted.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func groupsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	filters, err := expandFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	groups, err := listAll(ctx, filters, (*corev1.Group).GetId, func(ctx context.Context, filter *corev1.Filter, cursor string) ([]*corev1.Group, string, error) {
+		res, err := c.Grpc.Sdk.GroupServiceClient.ListGroups(ctx, &corev1.ListGroupsRequest{
+			Filter: filter,
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Groups, res.NextCursor, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(groups))
+	items := make([]map[string]any, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, group.Id)
+		items = append(items, map[string]any{
+			"id":                     group.Id,
+			"name":                   group.Name,
+			"description":            group.Description,
+			"termination_protection": group.TerminationProtection,
+		})
+	}
+
+	d.SetId(listID(ids))
+	d.Set("ids", ids)
+	d.Set("groups", items)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func Hook() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Hook by ID or by name. Use either `id` or `name`, but not both.",
+		ReadContext: hookRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of this Hook.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the Hook to look up. Policies reference this name as `input.hooks.<name>`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"description": {
+				Description: "The hook description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"code": {
+				Description: "The hook implementation as JavaScript.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The hook status: `active` or `draft`. Only active hooks can be referenced by policies.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"timeout_ms": {
+				Description: "Maximum time in milliseconds the hook may run during policy evaluation.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"allowlisted_environment_variables": {
+				Description: "Names of process environment variables the hook may read via its second `env` argument.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allowlisted_network_hosts": {
+				Description: "Hostnames, IP addresses, and CIDR ranges the hook may contact at evaluation time.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Description: "When the hook was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "When the hook was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func hookRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var hook *corev1.Hook
+
+	if hookID, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.HookServiceClient.GetHook(ctx, &corev1.GetHookRequest{Id: hookID.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no hook found with id %s", hookID)
+			}
+			return diag.FromErr(err)
+		}
+		hook = res.Hook
+	} else {
+		name := d.Get("name").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res, err := c.Grpc.Sdk.HookServiceClient.ListHooks(ctx, &corev1.ListHooksRequest{
+			Filter: &corev1.Filter{
+				Field: &corev1.Field{
+					Key:      "name",
+					Operator: "equals",
+					Value:    filterValue,
+				},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(res.Hooks) == 0 {
+			return diag.Errorf("no hook found with name %s", name)
+		}
+		hook = res.Hooks[0]
+	}
+
+	d.SetId(hook.Id)
+	d.Set("name", hook.Name)
+	d.Set("description", hook.Description)
+	d.Set("code", hook.Code)
+	d.Set("status", hook.Status)
+	d.Set("timeout_ms", int(hook.TimeoutMs))
+	d.Set("allowlisted_environment_variables", hook.AllowlistedEnvironmentVariables)
+	d.Set("allowlisted_network_hosts", hook.AllowlistedNetworkHosts)
+	if hook.CreatedAt != nil {
+		d.Set("created_at", hook.CreatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+	if hook.UpdatedAt != nil {
+		d.Set("updated_at", hook.UpdatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package datasources
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func IntegrationCloud() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Cloud Integration by ID or by name, for example to pass its outputs to the AWS or GCP modules from another workspace. Use either `id` or `name`, but not both.",
+		ReadContext: integrationCloudRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of the Cloud Integration to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the Cloud Integration to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"type": {
+				Description: "Cloud provider of the Integration: `aws` or `gcp`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cloud_region": {
+				Description: "Region of the cloud provider. (AWS only)",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"aws_template_body": {
+				Description: "The template body of the CloudFormation stack.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"aws_formal_stack_name": {
+				Description: "A generated name for your CloudFormation stack.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"aws_formal_iam_role": {
+				Description: "The IAM role ID Formal will use to access your resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"aws_formal_iam_role_arn": {
+				Description: "The ARN of the IAM role Formal will use to access your resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"aws_formal_pingback_arn": {
+				Description: "The SNS topic ARN CloudFormation can use to send events to Formal.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"aws_s3_bucket_arn": {
+				Description: "The AWS S3 bucket ARN this Cloud Integration is allowed to use for Log Integrations, if it is allowed to access S3.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"aws_formal_role_arn": {
+				Description: "The AWS IAM role ARN Formal uses to federate into your GCP workload identity pool.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gcp_project_id": {
+				Description: "The GCP project ID this integration grants Formal access to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gcp_service_account_email": {
+				Description: "The GCP service account email created for this integration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gcp_workload_identity_pool_provider": {
+				Description: "The GCP workload identity pool provider created for this integration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gcp_allow_gcs_access": {
+				Description: "Whether the Cloud Integration is allowed to write logs to GCS.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"gcp_gcs_buckets": {
+				Description: "The GCS buckets this Cloud Integration is allowed to write logs to. Empty with access allowed means all buckets in the project.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"gcp_permissions": {
+				Description: "The IAM permissions to grant Formal's service account, derived from the enabled capabilities. Pass these to the GCP Terraform module, which grants them through a single custom role.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func integrationCloudRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var integration *corev1.CloudIntegration
+
+	if id, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.IntegrationCloudServiceClient.GetIntegrationCloud(ctx, &corev1.GetIntegrationCloudRequest{Id: id.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no cloud integration found with id %s", id)
+			}
+			return diag.FromErr(err)
+		}
+		integration = res.Cloud
+	} else {
+		name := d.Get("name").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res, err := c.Grpc.Sdk.IntegrationCloudServiceClient.ListCloudIntegrations(ctx, &corev1.ListCloudIntegrationsRequest{
+			Filter: &corev1.Filter{
+				Field: &corev1.Field{
+					Key:      "name",
+					Operator: "equals",
+					Value:    filterValue,
+				},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(res.Clouds) == 0 {
+			return diag.Errorf("no cloud integration found with name %s", name)
+		}
+		integration = res.Clouds[0]
+	}
+
+	d.SetId(integration.Id)
+	d.Set("name", integration.Name)
+	d.Set("gcp_gcs_buckets", []string{})
+	d.Set("gcp_permissions", []string{})
+
+	switch data := integration.Cloud.(type) {
+	case *corev1.CloudIntegration_Aws:
+		d.Set("type", "aws")
+		d.Set("cloud_region", data.Aws.AwsCloudRegion)
+		d.Set("aws_template_body", data.Aws.TemplateBody)
+		d.Set("aws_formal_stack_name", data.Aws.AwsFormalStackName)
+		d.Set("aws_formal_iam_role", data.Aws.AwsFormalIamRole)
+		d.Set("aws_formal_iam_role_arn", data.Aws.AwsFormalIamRoleArn)
+		d.Set("aws_formal_pingback_arn", data.Aws.AwsFormalPingbackArn)
+		d.Set("aws_s3_bucket_arn", data.Aws.AwsS3BucketArn)
+	case *corev1.CloudIntegration_Gcp:
+		d.Set("type", "gcp")
+		d.Set("aws_formal_role_arn", data.Gcp.AwsFormalRoleArn)
+		d.Set("gcp_project_id", data.Gcp.GcpProjectId)
+		d.Set("gcp_service_account_email", data.Gcp.GcpServiceAccountEmail)
+		d.Set("gcp_workload_identity_pool_provider", data.Gcp.GcpWorkloadIdentityPoolProvider)
+		d.Set("gcp_allow_gcs_access", data.Gcp.GcpAllowGcsAccess)
+		d.Set("gcp_gcs_buckets", data.Gcp.GcpGcsBuckets)
+		d.Set("gcp_permissions", data.Gcp.GcpPermissions)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package datasources
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+var logCompressionNames = map[corev1.LogCompression]string{
+	corev1.LogCompression_LOG_COMPRESSION_NONE: "none",
+	corev1.LogCompression_LOG_COMPRESSION_GZIP: "gzip",
+	corev1.LogCompression_LOG_COMPRESSION_ZSTD: "zstd",
+}
+
+func IntegrationLog() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Log Integration by ID or by name. Use either `id` or `name`, but not both. Credentials of Datadog and Splunk integrations are never returned.",
+		ReadContext: integrationLogRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of the Log Integration to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the Log Integration to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"termination_protection": {
+				Description: "If set to true, this Log Integration cannot be deleted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "Creation time of the Log Integration.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"aws_s3": {
+				Description: "Configuration of the AWS S3 integration, if the integration writes to S3.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Description: "AWS Region.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"s3_bucket_name": {
+							Description: "AWS S3 Bucket Name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"s3_bucket_prefix": {
+							Description: "AWS S3 bucket prefix logs are written under.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"compression": {
+							Description: "Codec each log object is compressed with: `none`, `gzip` or `zstd`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cloud_integration_id": {
+							Description: "Cloud Integration ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"gcs": {
+				Description: "Configuration of the Google Cloud Storage integration, if the integration writes to GCS.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gcs_bucket_name": {
+							Description: "GCS Bucket Name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"gcs_bucket_prefix": {
+							Description: "GCS bucket prefix logs are written under.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"compression": {
+							Description: "Codec each log object is compressed with: `none`, `gzip` or `zstd`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cloud_integration_id": {
+							Description: "Cloud Integration ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func integrationLogRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var integration *corev1.IntegrationLog
+
+	if id, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.IntegrationsLogServiceClient.GetIntegrationLog(ctx, &corev1.GetIntegrationLogRequest{Id: id.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no log integration found with id %s", id)
+			}
+			return diag.FromErr(err)
+		}
+		integration = res.Integration
+	} else {
+		name := d.Get("name").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res, err := c.Grpc.Sdk.IntegrationsLogServiceClient.ListIntegrationLogs(ctx, &corev1.ListIntegrationLogsRequest{
+			Filter: &corev1.Filter{
+				Field: &corev1.Field{
+					Key:      "name",
+					Operator: "equals",
+					Value:    filterValue,
+				},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(res.Integrations) == 0 {
+			return diag.Errorf("no log integration found with name %s", name)
+		}
+		integration = res.Integrations[0]
+	}
+
+	d.SetId(integration.Id)
+	d.Set("name", integration.Name)
+	d.Set("termination_protection", integration.TerminationProtection)
+	if integration.CreatedAt != nil {
+		d.Set("created_at", int(integration.CreatedAt.AsTime().Unix()))
+	}
+	if awsS3 := integration.GetAwsS3(); awsS3 != nil {
+		d.Set("aws_s3", []map[string]any{
+			{
+				"cloud_integration_id": awsS3.CloudIntegrationId,
+				"s3_bucket_name":       awsS3.BucketName,
+				"s3_bucket_prefix":     awsS3.BucketPrefix,
+				"region":               awsS3.Region,
+				"compression":          logCompressionNames[awsS3.Compression],
+			},
+		})
+	}
+	if gcs := integration.GetGcs(); gcs != nil {
+		d.Set("gcs", []map[string]any{
+			{
+				"cloud_integration_id": gcs.CloudIntegrationId,
+				"gcs_bucket_name":      gcs.BucketName,
+				"gcs_bucket_prefix":    gcs.BucketPrefix,
+				"compression":          logCompressionNames[gcs.Compression],
+			},
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package datasources
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func NativeUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Native User by ID or by username. Use either `id` or `native_user_id`, but not both. The secret of the Native User is never returned.",
+		ReadContext: nativeUserRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of the Native User to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "native_user_id"},
+			},
+			"resource_id": {
+				Description: "The ID of the Resource this Native User is for. When looking up by `native_user_id`, set it to pick the Native User of this Resource.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"native_user_id": {
+				Description:  "The username of the Native User to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "native_user_id"},
+			},
+			"use_as_default": {
+				Description: "Whether the Connector uses this Native User when a connection does not name one.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"termination_protection": {
+				Description: "If set to true, this Native User cannot be deleted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func nativeUserRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var nativeUser *corev1.NativeUser
+
+	if id, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.GetNativeUser(ctx, &corev1.GetNativeUserRequest{Id: id.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no native user found with id %s", id)
+			}
+			return diag.FromErr(err)
+		}
+		nativeUser = res.NativeUser
+	} else {
+		username := d.Get("native_user_id").(string)
+		resourceID := d.Get("resource_id").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: username,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filter := &corev1.Filter{
+			Field: &corev1.Field{
+				Key:      "username",
+				Operator: "equals",
+				Value:    filterValue,
+			},
+		}
+		// Usernames are only unique within a Resource, so page through the
+		// Native Users with this username until one is for resource_id.
+		var found bool
+		nativeUser, found, err = paging.Find(ctx, func(ctx context.Context, cursor string) ([]*corev1.NativeUser, string, error) {
+			res, err := c.Grpc.Sdk.ResourceServiceClient.ListNativeUsers(ctx, &corev1.ListNativeUsersRequest{
+				Filter: filter,
+				Limit:  paging.PageSize,
+				Cursor: cursor,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			return res.NativeUsers, res.NextCursor, nil
+		}, func(nativeUser *corev1.NativeUser) bool {
+			return resourceID == "" || nativeUser.ResourceId == resourceID
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !found {
+			if resourceID != "" {
+				return diag.Errorf("no native user found with username %s for resource %s", username, resourceID)
+			}
+			return diag.Errorf("no native user found with username %s", username)
+		}
+	}
+
+	d.SetId(nativeUser.Id)
+	d.Set("resource_id", nativeUser.ResourceId)
+	d.Set("native_user_id", nativeUser.Username)
+	d.Set("use_as_default", nativeUser.UseAsDefault)
+	d.Set("termination_protection", nativeUser.TerminationProtection)
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func Permission() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Permission by ID or by name. Use either `id` or `name`, but not both.",
+		ReadContext: permissionRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of this Permission.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the Permission to look up. Use this to fetch a permission by name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"description": {
+				Description: "Description of this Permission.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"code": {
+				Description: "The code of this Permission, written in Rego.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of this Permission: `draft`, `dry-run` or `active`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"termination_protection": {
+				Description: "If set to true, this Permission cannot be deleted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "When the permission was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last update time.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func permissionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var permission *corev1.Permission
+
+	if permissionID, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.PermissionsServiceClient.GetPermission(ctx, &corev1.GetPermissionRequest{Id: permissionID.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no permission found with id %s", permissionID)
+			}
+			return diag.FromErr(err)
+		}
+		permission = res.Permission
+	} else {
+		name := d.Get("name").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res, err := c.Grpc.Sdk.PermissionsServiceClient.ListPermissions(ctx, &corev1.ListPermissionsRequest{
+			Filter: &corev1.Filter{
+				Field: &corev1.Field{
+					Key:      "name",
+					Operator: "equals",
+					Value:    filterValue,
+				},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(res.Permissions) == 0 {
+			return diag.Errorf("no permission found with name %s", name)
+		}
+		permission = res.Permissions[0]
+	}
+
+	d.SetId(permission.Id)
+	d.Set("name", permission.Name)
+	d.Set("description", permission.Description)
+	d.Set("code", permission.Code)
+	d.Set("status", permission.Status)
+	d.Set("termination_protection", permission.TerminationProtection)
+	if permission.CreatedAt != nil {
+		d.Set("created_at", permission.CreatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+	if permission.UpdatedAt != nil {
+		d.Set("updated_at", permission.UpdatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func Policy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Policy by ID or by name. Use either `id` or `name`, but not both.",
+		ReadContext: policyRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of this Policy.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the Policy to look up. Use this to fetch a policy by name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"description": {
+				Description: "Description of this Policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"module": {
+				Description: "The module describing the policy, written in Rego.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of this Policy: `draft`, `dry-run` or `active`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"termination_protection": {
+				Description: "If set to true, this Policy cannot be deleted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "When the policy was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last update time.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func policyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var policy *corev1.Policy
+
+	if policyID, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.PoliciesServiceClient.GetPolicy(ctx, &corev1.GetPolicyRequest{Id: policyID.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no policy found with id %s", policyID)
+			}
+			return diag.FromErr(err)
+		}
+		policy = res.Policy
+	} else {
+		name := d.Get("name").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res, err := c.Grpc.Sdk.PoliciesServiceClient.ListPolicies(ctx, &corev1.ListPoliciesRequest{
+			Filter: &corev1.Filter{
+				Field: &corev1.Field{
+					Key:      "name",
+					Operator: "equals",
+					Value:    filterValue,
+				},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(res.Policies) == 0 {
+			return diag.Errorf("no policy found with name %s", name)
+		}
+		policy = res.Policies[0]
+	}
+
+	d.SetId(policy.Id)
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("module", policy.Code)
+	d.Set("status", policy.Status)
+	d.Set("termination_protection", policy.TerminationProtection)
+	if policy.CreatedAt != nil {
+		d.Set("created_at", policy.CreatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+	if policy.UpdatedAt != nil {
+		d.Set("updated_at", policy.UpdatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
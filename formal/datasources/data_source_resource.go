@@ -2,6 +2,8 @@ package datasources
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,24 +13,25 @@ import (
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
 )
 
 func Resource() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for looking up a Resource by ID or by name. Use either `id` or `name`, but not both.",
+		Description: "Data source for looking up a Resource by ID, by name or by tags. Use exactly one of `id`, `name` or `filter_tags`.",
 		ReadContext: resourceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description:  "The ID of this Resource.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"id", "name"},
+				ExactlyOneOf: []string{"id", "name", "filter_tags"},
 			},
 			"name": {
 				Description:  "The name of the Resource to look up. Use this to fetch a resource by name.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"id", "name"},
+				ExactlyOneOf: []string{"id", "name", "filter_tags"},
 			},
 			"technology": {
 				Description: "Technology of the Resource.",
@@ -65,6 +68,30 @@ func Resource() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"filter_tags": {
+				Description:  "Tags of the Resource to look up, for example `{ env = \"prod\", team = \"payments\" }`. The Resource to look up is the only one having all these tags, and it is an error for several Resources to match.",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"id", "name", "filter_tags"},
+			},
+			"tags": {
+				Description: "The tags of the Resource.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"aliases": {
+				Description: "Aliases of the Resource.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"technology_provider": {
+				Description: "For SSH resources, the backend connection provider, for example `aws-ec2` or `aws-ecs`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -84,6 +111,12 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 			return diag.FromErr(err)
 		}
 		resource = res.Resource
+	} else if tags, ok := d.GetOk("filter_tags"); ok {
+		var err error
+		resource, err = resourceByTags(ctx, c, tags.(map[string]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	} else {
 		name := d.Get("name").(string)
 		filterValue, err := anypb.New(&wrapperspb.StringValue{
@@ -124,6 +157,66 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	if resource.CreatedAt != nil {
 		d.Set("created_at", resource.CreatedAt.AsTime().Unix())
 	}
+	d.Set("technology_provider", resource.Provider)
+	d.Set("aliases", resource.Aliases)
+	d.Set("tags", resourceTags(resource))
 
 	return diags
 }
+
+// resourceByTags returns the only Resource having all the given tags.
+func resourceByTags(ctx context.Context, c *clients.Clients, tags map[string]any) (*corev1.Resource, error) {
+	resources, err := listAll(ctx, nil, (*corev1.Resource).GetId, func(ctx context.Context, filter *corev1.Filter, cursor string) ([]*corev1.Resource, string, error) {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.ListResources(ctx, &corev1.ListResourcesRequest{
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Resources, res.NextCursor, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []*corev1.Resource
+	for _, resource := range resources {
+		if hasTags(resourceTags(resource), tags) {
+			matching = append(matching, resource)
+		}
+	}
+	switch len(matching) {
+	case 0:
+		return nil, fmt.Errorf("no resource found with tags %v", tags)
+	case 1:
+		return matching[0], nil
+	default:
+		names := make([]string, 0, len(matching))
+		for _, resource := range matching {
+			names = append(names, resource.Name)
+		}
+		return nil, fmt.Errorf("%d resources found with tags %v: %s; add tags to match only one", len(matching), tags, strings.Join(names, ", "))
+	}
+}
+
+func resourceTags(resource *corev1.Resource) map[string]string {
+	tags := make(map[string]string, len(resource.Tags))
+	for _, tag := range resource.Tags {
+		if tag == nil {
+			continue
+		}
+		tags[tag.Key] = tag.Value
+	}
+	return tags
+}
+
+// hasTags reports whether tags include every key and value of want.
+func hasTags(tags map[string]string, want map[string]any) bool {
+	for key, value := range want {
+		if v, ok := tags[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
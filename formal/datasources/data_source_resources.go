@@ -0,0 +1,133 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func Resources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing the Resources matching a set of filters, for example to create a Native User link for each of them with `for_each`.",
+		ReadContext: resourcesRead,
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema("Resources"),
+			"ids": {
+				Description: "The IDs of the matching Resources.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Description: "The matching Resources.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of this Resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"technology": {
+							Description: "Technology of the Resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"hostname": {
+							Description: "Hostname of the Resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"port": {
+							Description: "The port your Resource is listening on.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "Creation time of the Resource.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"environment": {
+							Description: "Environment for the Resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"termination_protection": {
+							Description: "If set to true, the Resource cannot be deleted.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"space_id": {
+							Description: "The ID of the Space the Resource is in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	filters, err := expandFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resources, err := listAll(ctx, filters, (*corev1.Resource).GetId, func(ctx context.Context, filter *corev1.Filter, cursor string) ([]*corev1.Resource, string, error) {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.ListResources(ctx, &corev1.ListResourcesRequest{
+			Filter: filter,
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Resources, res.NextCursor, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(resources))
+	items := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		item := map[string]any{
+			"id":                     resource.Id,
+			"name":                   resource.Name,
+			"technology":             resource.Technology,
+			"hostname":               resource.Hostname,
+			"port":                   int(resource.Port),
+			"environment":            resource.Environment,
+			"termination_protection": resource.TerminationProtection,
+		}
+		if resource.Space != nil {
+			item["space_id"] = resource.Space.Id
+		}
+		if resource.CreatedAt != nil {
+			item["created_at"] = int(resource.CreatedAt.AsTime().Unix())
+		}
+		ids = append(ids, resource.Id)
+		items = append(items, item)
+	}
+
+	d.SetId(listID(ids))
+	d.Set("ids", ids)
+	d.Set("resources", items)
+
+	return nil
+}
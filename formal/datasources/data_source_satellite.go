@@ -0,0 +1,117 @@
+package datasources
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func Satellite() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Satellite by ID or by name. Use either `id` or `name`, but not both.",
+		ReadContext: satelliteRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of this Satellite.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the Satellite to look up. Use this to fetch a satellite by name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"satellite_type": {
+				Description: "Type of the Satellite.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			// There is no tls_cert: Satellites have no TLS certificate, and the
+			// deprecated tls_cert of formal_satellite is always empty.
+			"api_key": {
+				Description: "Api key of the Satellite. Only set when the provider's `retrieve_sensitive_values` is true.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"termination_protection": {
+				Description: "If set to true, this Satellite cannot be deleted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"space_id": {
+				Description: "The ID of the Space the Satellite is in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func satelliteRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var satellite *corev1.Satellite
+
+	if satelliteID, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.SatelliteServiceClient.GetSatellite(ctx, &corev1.GetSatelliteRequest{Id: satelliteID.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no satellite found with id %s", satelliteID)
+			}
+			return diag.FromErr(err)
+		}
+		satellite = res.Satellite
+	} else {
+		name := d.Get("name").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res, err := c.Grpc.Sdk.SatelliteServiceClient.ListSatellites(ctx, &corev1.ListSatellitesRequest{
+			Filter: &corev1.Filter{
+				Field: &corev1.Field{
+					Key:      "name",
+					Operator: "equals",
+					Value:    filterValue,
+				},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(res.Satellites) == 0 {
+			return diag.Errorf("no satellite found with name %s", name)
+		}
+		satellite = res.Satellites[0]
+	}
+
+	d.SetId(satellite.Id)
+	d.Set("name", satellite.Name)
+	d.Set("satellite_type", satellite.SatelliteType)
+	d.Set("termination_protection", satellite.TerminationProtection)
+	if satellite.Space != nil {
+		d.Set("space_id", satellite.Space.Id)
+	}
+	if c.Grpc.ReturnSensitiveValue {
+		res, err := c.Grpc.Sdk.SatelliteServiceClient.GetSatelliteApiKey(ctx, &corev1.GetSatelliteApiKeyRequest{Id: satellite.Id})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("api_key", res.ApiKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func Spaces() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing the Spaces matching a set of filters.",
+		ReadContext: spacesRead,
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema("Spaces"),
+			"ids": {
+				Description: "The IDs of the matching Spaces.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"spaces": {
+				Description: "The matching Spaces.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The Formal ID for this Space.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Space.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the Space.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "Creation time of the Space.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"termination_protection": {
+							Description: "If set to true, this Space cannot be deleted.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func spacesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	filters, err := expandFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	spaces, err := listAll(ctx, filters, (*corev1.Space).GetId, func(ctx context.Context, filter *corev1.Filter, cursor string) ([]*corev1.Space, string, error) {
+		res, err := c.Grpc.Sdk.SpaceServiceClient.ListSpaces(ctx, &corev1.ListSpacesRequest{
+			Filter: filter,
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Spaces, res.NextCursor, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(spaces))
+	items := make([]map[string]any, 0, len(spaces))
+	for _, space := range spaces {
+		item := map[string]any{
+			"id":                     space.Id,
+			"name":                   space.Name,
+			"description":            space.Description,
+			"termination_protection": space.TerminationProtection,
+		}
+		if space.CreatedAt != nil {
+			item["created_at"] = int(space.CreatedAt.AsTime().Unix())
+		}
+		ids = append(ids, space.Id)
+		items = append(items, item)
+	}
+
+	d.SetId(listID(ids))
+	d.Set("ids", ids)
+	d.Set("spaces", items)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package datasources
 
 import (
 	"context"
+	"fmt"
 
 	"connectrpc.com/connect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,25 +11,27 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/api"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
 )
 
 func User() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source for looking up a User by ID or by identity. Use either `id` or `db_username`, but not both. A human user's identity is `idp:formal:human:<email>`, so this can resolve an email to a user ID, for example to add a user to a Group with `formal_group_link_user`.",
+		Description: "Data source for looking up a User by ID, by identity or by email. Use exactly one of `id`, `db_username` or `email`. Looking up a human user by email resolves it to a user ID, for example to add a user to a Group with `formal_group_link_user`.",
 		ReadContext: userRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description:  "The ID of the User to look up.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"id", "db_username"},
+				ExactlyOneOf: []string{"id", "db_username", "email"},
 			},
 			"db_username": {
 				Description:  "The identity of the User to look up, for example `idp:formal:human:jane@example.com`.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"id", "db_username"},
+				ExactlyOneOf: []string{"id", "db_username", "email"},
 			},
 			"type": {
 				Description: "The type of this User, either `human` or `machine`.",
@@ -46,9 +49,11 @@ func User() *schema.Resource {
 				Computed:    true,
 			},
 			"email": {
-				Description: "The email of this User. Only set for human users.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The email of the human User to look up. Only set for human users.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "db_username", "email"},
 			},
 			"full_name": {
 				Description: "The full name of this User.",
@@ -61,6 +66,35 @@ func User() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"groups": {
+				Description: "The Groups this User belongs to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the Group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"termination_protection": {
+							Description: "If set to true, the Group cannot be deleted.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"termination_protection": {
 				Description: "If set to true, this User cannot be deleted.",
 				Type:        schema.TypeBool,
@@ -87,6 +121,9 @@ func userRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagno
 		user = res.User
 	} else {
 		dbUsername := d.Get("db_username").(string)
+		if email, ok := d.GetOk("email"); ok {
+			dbUsername = api.HumanIdentityPrefix + email.(string)
+		}
 		filterValue, err := anypb.New(&wrapperspb.StringValue{Value: dbUsername})
 		if err != nil {
 			return diag.FromErr(err)
@@ -105,16 +142,25 @@ func userRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagno
 			return diag.FromErr(err)
 		}
 		if len(res.Users) == 0 {
+			if email, ok := d.GetOk("email"); ok {
+				return diag.Errorf("no user found with email %s", email)
+			}
 			return diag.Errorf("no user found with db_username %s", dbUsername)
 		}
 		user = res.Users[0]
 	}
 
+	groups, err := userGroups(ctx, c, user.GroupIds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(user.Id)
 	d.Set("db_username", user.DbUsername)
 	d.Set("full_name", user.FullName)
 	d.Set("type", user.Type)
 	d.Set("group_ids", user.GroupIds)
+	d.Set("groups", groups)
 	d.Set("termination_protection", user.TerminationProtection)
 	if human := user.GetHuman(); human != nil {
 		d.Set("first_name", human.FirstName)
@@ -124,3 +170,54 @@ func userRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagno
 
 	return diags
 }
+
+// userGroups returns the groups with the given IDs, in the same order. It
+// pages through the groups of the organization once, rather than getting
+// each group, and stops as soon as it has found them all.
+func userGroups(ctx context.Context, c *clients.Clients, ids []string) ([]map[string]any, error) {
+	groups := make([]map[string]any, 0, len(ids))
+	if len(ids) == 0 {
+		return groups, nil
+	}
+
+	wanted := make(map[string]*corev1.Group, len(ids))
+	for _, id := range ids {
+		wanted[id] = nil
+	}
+	remaining := len(wanted)
+	for group, err := range paging.All(ctx, func(ctx context.Context, cursor string) ([]*corev1.Group, string, error) {
+		res, err := c.Grpc.Sdk.GroupServiceClient.ListGroups(ctx, &corev1.ListGroupsRequest{
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Groups, res.NextCursor, nil
+	}) {
+		if err != nil {
+			return nil, err
+		}
+		if found, ok := wanted[group.Id]; ok && found == nil {
+			wanted[group.Id] = group
+			remaining--
+		}
+		if remaining == 0 {
+			break
+		}
+	}
+
+	for _, id := range ids {
+		group := wanted[id]
+		if group == nil {
+			return nil, fmt.Errorf("no group found with id %s", id)
+		}
+		groups = append(groups, map[string]any{
+			"id":                     group.Id,
+			"name":                   group.Name,
+			"description":            group.Description,
+			"termination_protection": group.TerminationProtection,
+		})
+	}
+	return groups, nil
+}
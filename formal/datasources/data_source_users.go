@@ -0,0 +1,132 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func Users() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for listing the Users matching a set of filters, for example every machine user with a `type` filter.",
+		ReadContext: usersRead,
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema("Users"),
+			"ids": {
+				Description: "The IDs of the matching Users.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Description: "The matching Users.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of this User.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"db_username": {
+							Description: "The identity of this User, for example `idp:formal:human:jane@example.com`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of this User, either `human` or `machine`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"first_name": {
+							Description: "The first name of this User. Only set for human users.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_name": {
+							Description: "The last name of this User. Only set for human users.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "The email of this User. Only set for human users.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"full_name": {
+							Description: "The full name of this User.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"group_ids": {
+							Description: "The IDs of the Groups this User belongs to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"termination_protection": {
+							Description: "If set to true, this User cannot be deleted.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func usersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	filters, err := expandFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	users, err := listAll(ctx, filters, (*corev1.User).GetId, func(ctx context.Context, filter *corev1.Filter, cursor string) ([]*corev1.User, string, error) {
+		res, err := c.Grpc.Sdk.UserServiceClient.ListUsers(ctx, &corev1.ListUsersRequest{
+			Filter: filter,
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Users, res.NextCursor, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(users))
+	items := make([]map[string]any, 0, len(users))
+	for _, user := range users {
+		item := map[string]any{
+			"id":                     user.Id,
+			"db_username":            user.DbUsername,
+			"type":                   user.Type,
+			"full_name":              user.FullName,
+			"group_ids":              user.GroupIds,
+			"termination_protection": user.TerminationProtection,
+		}
+		if human := user.GetHuman(); human != nil {
+			item["first_name"] = human.FirstName
+			item["last_name"] = human.LastName
+			item["email"] = human.Email
+		}
+		ids = append(ids, user.Id)
+		items = append(items, item)
+	}
+
+	d.SetId(listID(ids))
+	d.Set("ids", ids)
+	d.Set("users", items)
+
+	return nil
+}
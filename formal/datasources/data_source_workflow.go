@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func Workflow() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for looking up a Workflow by ID or by name. Use either `id` or `name`, but not both.",
+		ReadContext: workflowRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of this Workflow.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the Workflow to look up. Use this to fetch a workflow by name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"code": {
+				Description: "The code of this Workflow.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of this Workflow: `draft` or `active`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "When the workflow was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Last update time.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func workflowRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*clients.Clients)
+
+	var workflow *corev1.Workflow
+
+	if workflowID, ok := d.GetOk("id"); ok {
+		res, err := c.Grpc.Sdk.WorkflowServiceClient.GetWorkflow(ctx, &corev1.GetWorkflowRequest{Id: workflowID.(string)})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return diag.Errorf("no workflow found with id %s", workflowID)
+			}
+			return diag.FromErr(err)
+		}
+		workflow = res.Workflow
+	} else {
+		name := d.Get("name").(string)
+		filterValue, err := anypb.New(&wrapperspb.StringValue{
+			Value: name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		res, err := c.Grpc.Sdk.WorkflowServiceClient.ListWorkflows(ctx, &corev1.ListWorkflowsRequest{
+			Filter: &corev1.Filter{
+				Field: &corev1.Field{
+					Key:      "name",
+					Operator: "equals",
+					Value:    filterValue,
+				},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(res.Workflows) == 0 {
+			return diag.Errorf("no workflow found with name %s", name)
+		}
+		workflow = res.Workflows[0]
+	}
+
+	d.SetId(workflow.Id)
+	d.Set("name", workflow.Name)
+	d.Set("code", workflow.Code)
+	d.Set("status", workflow.GetStatus())
+	if workflow.CreatedAt != nil {
+		d.Set("created_at", workflow.CreatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+	if workflow.UpdatedAt != nil {
+		d.Set("updated_at", workflow.UpdatedAt.AsTime().UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
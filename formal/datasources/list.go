@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+// filterSchema is the filter block of the data sources listing objects.
+func filterSchema(objects string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Filter on the %s to return. When several filters are set, only the %s matching all of them are returned.", objects, objects),
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Description: "The field to filter on, for example `name`.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"operator": {
+					Description: "The comparison operator of the filter, for example `equals` or `contains`. Defaults to `equals`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "equals",
+				},
+				"value": {
+					Description: "The value to compare the field with.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// expandFilters converts the filter blocks of a data source to API filters.
+func expandFilters(d *schema.ResourceData) ([]*corev1.Filter, error) {
+	var filters []*corev1.Filter
+	for _, raw := range d.Get("filter").([]any) {
+		block := raw.(map[string]any)
+		value, err := anypb.New(wrapperspb.String(block["value"].(string)))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, &corev1.Filter{
+			Field: &corev1.Field{
+				Key:      block["key"].(string),
+				Operator: block["operator"].(string),
+				Value:    value,
+			},
+		})
+	}
+	return filters, nil
+}
+
+// listPage lists one page of objects matching filter, which is nil when
+// listing every object, and returns the cursor of the next page.
+type listPage[T any] func(ctx context.Context, filter *corev1.Filter, cursor string) (objects []T, next string, err error)
+
+// listAll pages through the objects matching each filter, and returns those
+// matching all of them in the order of the first filter's listing.
+func listAll[T any](ctx context.Context, filters []*corev1.Filter, id func(T) string, list listPage[T]) ([]T, error) {
+	if len(filters) == 0 {
+		return listPages(ctx, nil, list)
+	}
+
+	objects, err := listPages(ctx, filters[0], list)
+	if err != nil {
+		return nil, err
+	}
+	for _, filter := range filters[1:] {
+		matching, err := listPages(ctx, filter, list)
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]bool, len(matching))
+		for _, object := range matching {
+			ids[id(object)] = true
+		}
+		objects = slices.DeleteFunc(objects, func(object T) bool {
+			return !ids[id(object)]
+		})
+	}
+	return objects, nil
+}
+
+// listPages pages through the objects matching filter.
+func listPages[T any](ctx context.Context, filter *corev1.Filter, list listPage[T]) ([]T, error) {
+	return paging.Collect(ctx, func(ctx context.Context, cursor string) ([]T, string, error) {
+		return list(ctx, filter, cursor)
+	})
+}
+
+// listID returns the ID of a data source listing the objects with the given
+// IDs, which changes when the list does.
+func listID(ids []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(ids, ",")))
+	return hex.EncodeToString(sum[:])
+}
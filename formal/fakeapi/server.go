@@ -0,0 +1,224 @@
+// Package fakeapi serves an in-memory Formal API on an httptest.Server, so that
+// the provider can be tested without network access or a Formal organization.
+//
+// The server implements every unary method of the core.v1 Connect services of
+// the SDK by convention on the method name, using the request and response
+// descriptors: Create<X> stores a new X built from the request fields, Get<X>,
+// Update<X> and Delete<X> look X up by id, and List<Xs> pages through the
+// stored Xs matching the request filter, with the equals or contains operator.
+// Methods that need a specific behavior can be replaced with Server.Handle,
+// and objects that Terraform doesn't create can be stored with Server.Put.
+package fakeapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	// Registers the core.v1 services served by NewServer.
+	_ "github.com/formalco/go-sdk/v3/core/v1"
+)
+
+// CorePackage is the proto package of the Formal API services.
+const CorePackage protoreflect.FullName = "core.v1"
+
+// APIKey is the API key to configure the provider with. The fake server
+// doesn't check it, but returns it from its OIDC token exchange.
+const APIKey = "fake-formal-api-key"
+
+// HandlerFunc replaces the conventional behavior of a method. res is an empty
+// response message to fill in.
+type HandlerFunc func(ctx context.Context, req, res protoreflect.Message) error
+
+// Server is an in-memory Formal API.
+type Server struct {
+	// URL is the base URL of the server, to use as the provider base_url.
+	URL string
+
+	httpServer *httptest.Server
+
+	mu       sync.Mutex
+	store    *store
+	handlers map[string]HandlerFunc
+	calls    []string
+}
+
+// NewServer starts a fake Formal API serving the core.v1 services of the SDK.
+// It is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	var services []protoreflect.ServiceDescriptor
+	protoregistry.GlobalFiles.RangeFilesByPackage(CorePackage, func(file protoreflect.FileDescriptor) bool {
+		for i := range file.Services().Len() {
+			services = append(services, file.Services().Get(i))
+		}
+		return true
+	})
+	if len(services) == 0 {
+		t.Fatalf("no %s service is registered", CorePackage)
+	}
+	return NewServerForServices(t, services...)
+}
+
+// NewServerForServices starts a fake Formal API serving the given services.
+// It is closed when the test ends.
+func NewServerForServices(t testing.TB, services ...protoreflect.ServiceDescriptor) *Server {
+	t.Helper()
+
+	files := make([]protoreflect.FileDescriptor, 0, len(services))
+	for _, service := range services {
+		files = append(files, service.ParentFile())
+	}
+	s := &Server{
+		store:    newStore(files),
+		handlers: map[string]HandlerFunc{},
+	}
+
+	mux := http.NewServeMux()
+	for _, service := range services {
+		methods := service.Methods()
+		for i := range methods.Len() {
+			method := methods.Get(i)
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				continue
+			}
+			procedure := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+			mux.Handle(procedure, s.newHandler(procedure, method))
+		}
+	}
+	mux.HandleFunc("/oidc/token", s.serveTokenExchange)
+
+	s.httpServer = httptest.NewServer(mux)
+	s.URL = s.httpServer.URL
+	t.Cleanup(s.httpServer.Close)
+
+	return s
+}
+
+// Setenv points the provider at the server through FORMAL_BASE_URL and
+// FORMAL_API_KEY for the duration of the test.
+func (s *Server) Setenv(t testing.TB) {
+	t.Helper()
+
+	t.Setenv("FORMAL_BASE_URL", s.URL)
+	t.Setenv("FORMAL_API_KEY", APIKey)
+}
+
+// ProviderConfig returns a provider block targeting the server.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "formal" {
+  api_key  = %q
+  base_url = %q
+}
+`, APIKey, s.URL)
+}
+
+// Handle replaces the behavior of the method with the given procedure, for
+// example "/core.v1.PoliciesService/GetPolicyCodeValidity".
+func (s *Server) Handle(procedure string, handler HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[procedure] = handler
+}
+
+// Calls returns the procedures called so far, in order.
+func (s *Server) Calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.calls...)
+}
+
+// Objects returns a copy of the stored objects of the given message type, for
+// example "core.v1.Resource".
+func (s *Server) Objects(name protoreflect.FullName) []protoreflect.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store.all(name)
+}
+
+// Put stores an object of the given message type from its JSON form, as if it
+// was created outside of Terraform, and returns its id. A missing id is
+// generated.
+func (s *Server) Put(name protoreflect.FullName, object string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	desc := s.store.messageType(name)
+	if desc == nil {
+		return "", fmt.Errorf("unknown message type %s", name)
+	}
+	obj := dynamicpb.NewMessage(desc)
+	if err := protojson.Unmarshal([]byte(object), obj); err != nil {
+		return "", fmt.Errorf("parsing %s: %w", name, err)
+	}
+	s.store.insert(obj)
+	return stringField(obj, "id"), nil
+}
+
+// Delete removes a stored object as if it was deleted outside of Terraform.
+func (s *Server) Delete(name protoreflect.FullName, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store.delete(name, id)
+}
+
+func (s *Server) newHandler(procedure string, method protoreflect.MethodDescriptor) http.Handler {
+	return connect.NewUnaryHandler(
+		procedure,
+		func(ctx context.Context, req *connect.Request[dynamicpb.Message]) (*connect.Response[dynamicpb.Message], error) {
+			res := dynamicpb.NewMessage(method.Output())
+			if err := s.serve(ctx, procedure, method, req.Msg, res); err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(res), nil
+		},
+		connect.WithSchema(method),
+		connect.WithRequestInitializer(func(spec connect.Spec, msg any) error {
+			dynamic, ok := msg.(*dynamicpb.Message)
+			if !ok {
+				return fmt.Errorf("unexpected request message %T", msg)
+			}
+			*dynamic = *dynamicpb.NewMessage(spec.Schema.(protoreflect.MethodDescriptor).Input())
+			return nil
+		}),
+	)
+}
+
+func (s *Server) serve(ctx context.Context, procedure string, method protoreflect.MethodDescriptor, req, res *dynamicpb.Message) error {
+	s.mu.Lock()
+	s.calls = append(s.calls, procedure)
+	handler, ok := s.handlers[procedure]
+	if ok {
+		// Handlers may call the Server, so they run without the lock.
+		s.mu.Unlock()
+		return handler(ctx, req, res)
+	}
+	defer s.mu.Unlock()
+
+	return s.store.handle(string(method.Name()), req, res)
+}
+
+func (s *Server) serveTokenExchange(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	s.mu.Lock()
+	s.calls = append(s.calls, r.URL.Path)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{"access_token": APIKey, "token_type": "Bearer", "expires_in": 3600})
+}
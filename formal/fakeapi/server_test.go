@@ -0,0 +1,466 @@
+package fakeapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestService returns a service shaped like the core.v1 ones.
+func newTestService(t *testing.T) protoreflect.ServiceDescriptor {
+	t.Helper()
+
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     typ.Enum(),
+			Label:    label.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	message := func(name string, fields ...*descriptorpb.FieldDescriptorProto) *descriptorpb.DescriptorProto {
+		return &descriptorpb.DescriptorProto{Name: proto.String(name), Field: fields}
+	}
+	method := func(name string) *descriptorpb.MethodDescriptorProto {
+		return &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(name),
+			InputType:  proto.String(".test.v1." + name + "Request"),
+			OutputType: proto.String(".test.v1." + name + "Response"),
+		}
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	str := descriptorpb.FieldDescriptorProto_TYPE_STRING
+	i32 := descriptorpb.FieldDescriptorProto_TYPE_INT32
+	msg := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	boolean := descriptorpb.FieldDescriptorProto_TYPE_BOOL
+
+	// UpdateResourceRequest.name is a proto3 optional field, with presence.
+	updateResourceRequest := message("UpdateResourceRequest",
+		field("id", 1, str, optional, ""),
+		field("name", 2, str, optional, ""),
+		field("aliases", 3, msg, optional, ".test.v1.Aliases"),
+		field("termination_protection", 4, boolean, optional, ""),
+	)
+	updateResourceRequest.Field[1].Proto3Optional = proto.Bool(true)
+	updateResourceRequest.Field[1].OneofIndex = proto.Int32(0)
+	updateResourceRequest.OneofDecl = []*descriptorpb.OneofDescriptorProto{{Name: proto.String("_name")}}
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("fakeapi_test.proto"),
+		Package:    proto.String("test.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/any.proto", "google/protobuf/timestamp.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			message("Space", field("id", 1, str, optional, "")),
+			message("Resource",
+				field("id", 1, str, optional, ""),
+				field("name", 2, str, optional, ""),
+				field("technology", 3, str, optional, ""),
+				field("aliases", 4, str, repeated, ""),
+				field("space", 5, msg, optional, ".test.v1.Space"),
+				field("created_at", 6, msg, optional, ".google.protobuf.Timestamp"),
+				field("updated_at", 7, msg, optional, ".google.protobuf.Timestamp"),
+				field("termination_protection", 8, boolean, optional, ""),
+			),
+			message("FilterField",
+				field("key", 1, str, optional, ""),
+				field("operator", 2, str, optional, ""),
+				field("value", 3, msg, optional, ".google.protobuf.Any"),
+			),
+			message("Filter", field("field", 1, msg, optional, ".test.v1.FilterField")),
+			message("Aliases", field("aliases", 1, str, repeated, "")),
+			message("CreateResourceRequest",
+				field("name", 1, str, optional, ""),
+				field("technology", 2, str, optional, ""),
+				field("space_id", 3, str, optional, ""),
+			),
+			message("CreateResourceResponse", field("resource", 1, msg, optional, ".test.v1.Resource")),
+			message("GetResourceRequest", field("id", 1, str, optional, "")),
+			message("GetResourceResponse", field("resource", 1, msg, optional, ".test.v1.Resource")),
+			updateResourceRequest,
+			message("UpdateResourceResponse", field("resource", 1, msg, optional, ".test.v1.Resource")),
+			message("DeleteResourceRequest", field("id", 1, str, optional, "")),
+			message("DeleteResourceResponse"),
+			message("ListResourcesRequest",
+				field("filter", 1, msg, optional, ".test.v1.Filter"),
+				field("limit", 2, i32, optional, ""),
+				field("cursor", 3, str, optional, ""),
+			),
+			message("ListResourcesResponse",
+				field("resources", 1, msg, repeated, ".test.v1.Resource"),
+				field("next_cursor", 2, str, optional, ""),
+			),
+			message("GetResourceApiKeyRequest", field("id", 1, str, optional, "")),
+			message("GetResourceApiKeyResponse", field("secret", 1, str, optional, "")),
+			message("ResourceHostname",
+				field("id", 1, str, optional, ""),
+				field("hostname", 2, str, optional, ""),
+				field("resource", 3, msg, optional, ".test.v1.Resource"),
+			),
+			message("CreateResourceHostnameRequest",
+				field("parent_resource_id", 1, str, optional, ""),
+				field("hostname", 2, str, optional, ""),
+			),
+			message("CreateResourceHostnameResponse", field("resource_hostname", 1, msg, optional, ".test.v1.ResourceHostname")),
+			message("GetResourceHostnameRequest", field("hostname_id", 1, str, optional, "")),
+			message("GetResourceHostnameResponse", field("resource_hostname", 1, msg, optional, ".test.v1.ResourceHostname")),
+			message("IntegrationGcp",
+				field("gcp_project_id", 1, str, optional, ""),
+				field("gcp_roles", 2, str, repeated, ""),
+			),
+			message("Integration",
+				field("id", 1, str, optional, ""),
+				field("name", 2, str, optional, ""),
+				field("database", 3, str, optional, ""),
+				field("gcp", 4, msg, optional, ".test.v1.IntegrationGcp"),
+			),
+			message("CreateIntegrationGcp", field("project_id", 1, str, optional, "")),
+			message("CreateIntegrationRequest",
+				field("name", 1, str, optional, ""),
+				field("database_name", 2, str, optional, ""),
+				field("gcp", 3, msg, optional, ".test.v1.CreateIntegrationGcp"),
+			),
+			message("CreateIntegrationResponse", field("id", 1, str, optional, "")),
+			message("GetIntegrationRequest", field("id", 1, str, optional, "")),
+			message("GetIntegrationResponse", field("integration", 1, msg, optional, ".test.v1.Integration")),
+			message("UpdateIntegrationRequest", field("integration", 1, msg, optional, ".test.v1.Integration")),
+			message("UpdateIntegrationResponse", field("integration", 1, msg, optional, ".test.v1.Integration")),
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("ResourceService"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					method("CreateResource"),
+					method("GetResource"),
+					method("UpdateResource"),
+					method("DeleteResource"),
+					method("ListResources"),
+					method("GetResourceApiKey"),
+					method("CreateResourceHostname"),
+					method("GetResourceHostname"),
+					method("CreateIntegration"),
+					method("GetIntegration"),
+					method("UpdateIntegration"),
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	return file.Services().ByName("ResourceService")
+}
+
+func call(t *testing.T, s *Server, service protoreflect.ServiceDescriptor, name, body string) (*dynamicpb.Message, error) {
+	t.Helper()
+
+	method := service.Methods().ByName(protoreflect.Name(name))
+	client := connect.NewClient[dynamicpb.Message, dynamicpb.Message](
+		http.DefaultClient,
+		s.URL+"/"+string(service.FullName())+"/"+name,
+		connect.WithSchema(method),
+		connect.WithResponseInitializer(func(spec connect.Spec, msg any) error {
+			*msg.(*dynamicpb.Message) = *dynamicpb.NewMessage(method.Output())
+			return nil
+		}),
+	)
+
+	req := dynamicpb.NewMessage(method.Input())
+	require.NoError(t, protojson.Unmarshal([]byte(body), req))
+	res, err := client.CallUnary(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg, nil
+}
+
+func toJSON(t *testing.T, m protoreflect.ProtoMessage, path string) any {
+	t.Helper()
+
+	body, err := protojson.Marshal(m)
+	require.NoError(t, err)
+	var v any
+	require.NoError(t, json.Unmarshal(body, &v))
+	for _, key := range strings.Split(path, ".") {
+		if key != "" {
+			v = v.(map[string]any)[key]
+		}
+	}
+	return v
+}
+
+func TestServerCRUD(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	res, err := call(t, s, service, "CreateResource", `{"name": "postgres", "technology": "postgres", "space_id": "space_1"}`)
+	require.NoError(t, err)
+	id := toJSON(t, res, "resource.id")
+	require.Equal(t, "resource_fake000001", id)
+	require.Equal(t, "space_1", toJSON(t, res, "resource.space.id"))
+	require.NotNil(t, toJSON(t, res, "resource.created_at"))
+
+	res, err = call(t, s, service, "GetResource", `{"id": "resource_fake000001"}`)
+	require.NoError(t, err)
+	require.Equal(t, "postgres", toJSON(t, res, "resource.name"))
+
+	res, err = call(t, s, service, "UpdateResource", `{"id": "resource_fake000001", "name": "renamed", "aliases": {"aliases": ["pg"]}}`)
+	require.NoError(t, err)
+	require.Equal(t, "renamed", toJSON(t, res, "resource.name"))
+	require.Equal(t, []any{"pg"}, toJSON(t, res, "resource.aliases"))
+	require.Equal(t, "postgres", toJSON(t, res, "resource.technology"))
+
+	objects := s.Objects("test.v1.Resource")
+	require.Len(t, objects, 1)
+	require.Equal(t, "renamed", stringField(objects[0], "name"))
+
+	_, err = call(t, s, service, "DeleteResource", `{"id": "resource_fake000001"}`)
+	require.NoError(t, err)
+
+	_, err = call(t, s, service, "GetResource", `{"id": "resource_fake000001"}`)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = call(t, s, service, "DeleteResource", `{"id": "resource_fake000001"}`)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	require.Equal(t, []string{
+		"/test.v1.ResourceService/CreateResource",
+		"/test.v1.ResourceService/GetResource",
+		"/test.v1.ResourceService/UpdateResource",
+		"/test.v1.ResourceService/DeleteResource",
+		"/test.v1.ResourceService/GetResource",
+		"/test.v1.ResourceService/DeleteResource",
+	}, s.Calls())
+}
+
+func TestServerUpdateZeroFields(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	_, err := s.Put("test.v1.Resource", `{"name": "postgres", "termination_protection": true}`)
+	require.NoError(t, err)
+
+	// termination_protection has no presence, so leaving it unset turns it
+	// off, while the unset optional name is left unchanged.
+	res, err := call(t, s, service, "UpdateResource", `{"id": "resource_fake000001"}`)
+	require.NoError(t, err)
+	require.Equal(t, "postgres", toJSON(t, res, "resource.name"))
+	require.Nil(t, toJSON(t, res, "resource.termination_protection"))
+
+	res, err = call(t, s, service, "UpdateResource", `{"id": "resource_fake000001", "termination_protection": true}`)
+	require.NoError(t, err)
+	require.Equal(t, true, toJSON(t, res, "resource.termination_protection"))
+}
+
+func TestServerList(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := call(t, s, service, "CreateResource", `{"name": "`+name+`"}`)
+		require.NoError(t, err)
+	}
+
+	res, err := call(t, s, service, "ListResources", `{}`)
+	require.NoError(t, err)
+	require.Len(t, toJSON(t, res, "resources"), 3)
+
+	res, err = call(t, s, service, "ListResources", `{"limit": 2}`)
+	require.NoError(t, err)
+	require.Len(t, toJSON(t, res, "resources"), 2)
+	require.Equal(t, "resource_fake000003", toJSON(t, res, "next_cursor"))
+
+	res, err = call(t, s, service, "ListResources", `{"limit": 2, "cursor": "resource_fake000003"}`)
+	require.NoError(t, err)
+	resources := toJSON(t, res, "resources").([]any)
+	require.Len(t, resources, 1)
+	require.Equal(t, "c", resources[0].(map[string]any)["name"])
+	require.Nil(t, toJSON(t, res, "next_cursor"))
+
+	_, err = call(t, s, service, "ListResources", `{"cursor": "resource_unknown"}`)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	res, err = call(t, s, service, "ListResources", `{"filter": {"field": {
+		"key": "name",
+		"operator": "equals",
+		"value": {"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "b"}
+	}}}`)
+	require.NoError(t, err)
+	resources = toJSON(t, res, "resources").([]any)
+	require.Len(t, resources, 1)
+	require.Equal(t, "b", resources[0].(map[string]any)["name"])
+
+	_, err = call(t, s, service, "CreateResource", `{"name": "ab"}`)
+	require.NoError(t, err)
+	res, err = call(t, s, service, "ListResources", `{"filter": {"field": {
+		"key": "name",
+		"operator": "contains",
+		"value": {"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "a"}
+	}}}`)
+	require.NoError(t, err)
+	require.Len(t, toJSON(t, res, "resources"), 2)
+
+	_, err = call(t, s, service, "ListResources", `{"filter": {"field": {
+		"key": "name",
+		"operator": "starts_with",
+		"value": {"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "a"}
+	}}}`)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestServerListFilterReference(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	for _, spaceID := range []string{"space_1", "space_2"} {
+		_, err := call(t, s, service, "CreateResource", `{"name": "postgres", "space_id": "`+spaceID+`"}`)
+		require.NoError(t, err)
+	}
+
+	// space_id is compared to the id of the space of each resource.
+	res, err := call(t, s, service, "ListResources", `{"filter": {"field": {
+		"key": "space_id",
+		"operator": "equals",
+		"value": {"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "space_2"}
+	}}}`)
+	require.NoError(t, err)
+	resources := toJSON(t, res, "resources").([]any)
+	require.Len(t, resources, 1)
+	require.Equal(t, "resource_fake000002", resources[0].(map[string]any)["id"])
+}
+
+func TestServerDeleteOutOfBand(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	_, err := call(t, s, service, "CreateResource", `{"name": "postgres"}`)
+	require.NoError(t, err)
+
+	require.True(t, s.Delete("test.v1.Resource", "resource_fake000001"))
+	require.False(t, s.Delete("test.v1.Resource", "resource_fake000001"))
+
+	_, err = call(t, s, service, "GetResource", `{"id": "resource_fake000001"}`)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestServerReferences(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	_, err := call(t, s, service, "CreateResource", `{"name": "postgres"}`)
+	require.NoError(t, err)
+
+	res, err := call(t, s, service, "CreateResourceHostname", `{"parent_resource_id": "resource_fake000001", "hostname": "db.example.com"}`)
+	require.NoError(t, err)
+	require.Equal(t, "resource_fake000001", toJSON(t, res, "resource_hostname.resource.id"))
+
+	res, err = call(t, s, service, "GetResourceHostname", `{"hostname_id": "resource_hostname_fake000002"}`)
+	require.NoError(t, err)
+	require.Equal(t, "db.example.com", toJSON(t, res, "resource_hostname.hostname"))
+
+	_, err = call(t, s, service, "GetResourceHostname", `{"hostname_id": "resource_fake000001"}`)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestServerCreateReturningID(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	res, err := call(t, s, service, "CreateIntegration", `{"name": "gcp", "database_name": "main", "gcp": {"project_id": "project-1"}}`)
+	require.NoError(t, err)
+	require.Equal(t, "integration_fake000001", toJSON(t, res, "id"))
+
+	res, err = call(t, s, service, "GetIntegration", `{"id": "integration_fake000001"}`)
+	require.NoError(t, err)
+	require.Equal(t, "main", toJSON(t, res, "integration.database"))
+	require.Equal(t, "project-1", toJSON(t, res, "integration.gcp.gcp_project_id"))
+
+	res, err = call(t, s, service, "UpdateIntegration", `{"integration": {"id": "integration_fake000001", "name": "renamed"}}`)
+	require.NoError(t, err)
+	require.Equal(t, "renamed", toJSON(t, res, "integration.name"))
+	require.Equal(t, "project-1", toJSON(t, res, "integration.gcp.gcp_project_id"))
+
+	_, err = call(t, s, service, "UpdateIntegration", `{"integration": {"id": "integration_unknown", "name": "renamed"}}`)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestServerPut(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	id, err := s.Put("test.v1.Resource", `{"name": "seeded", "space": {"id": "space_1"}}`)
+	require.NoError(t, err)
+	require.Equal(t, "resource_fake000001", id)
+
+	res, err := call(t, s, service, "GetResource", `{"id": "resource_fake000001"}`)
+	require.NoError(t, err)
+	require.Equal(t, "seeded", toJSON(t, res, "resource.name"))
+	require.NotNil(t, toJSON(t, res, "resource.created_at"))
+
+	_, err = s.Put("test.v1.Unknown", `{}`)
+	require.Error(t, err)
+	_, err = s.Put("test.v1.Resource", `{"unknown": true}`)
+	require.Error(t, err)
+}
+
+func TestServerPlaceholders(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	_, err := call(t, s, service, "CreateResource", `{"name": "postgres"}`)
+	require.NoError(t, err)
+
+	res, err := call(t, s, service, "GetResourceApiKey", `{"id": "resource_fake000001"}`)
+	require.NoError(t, err)
+	require.Equal(t, "fake-secret", toJSON(t, res, "secret"))
+
+	_, err = call(t, s, service, "GetResourceApiKey", `{"id": "resource_unknown"}`)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestServerHandle(t *testing.T) {
+	service := newTestService(t)
+	s := NewServerForServices(t, service)
+
+	s.Handle("/test.v1.ResourceService/GetResourceApiKey", func(_ context.Context, req, res protoreflect.Message) error {
+		res.Set(res.Descriptor().Fields().ByName("secret"), protoreflect.ValueOfString("key-for-"+stringField(req, "id")))
+		return nil
+	})
+
+	res, err := call(t, s, service, "GetResourceApiKey", `{"id": "resource_1"}`)
+	require.NoError(t, err)
+	require.Equal(t, "key-for-resource_1", toJSON(t, res, "secret"))
+}
+
+func TestServerTokenExchange(t *testing.T) {
+	s := NewServerForServices(t, newTestService(t))
+
+	res, err := http.PostForm(s.URL+"/oidc/token", url.Values{"subject_token": {"jwt"}})
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	require.Equal(t, APIKey, body.AccessToken)
+}
@@ -0,0 +1,667 @@
+package fakeapi
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// store keeps the objects created through the API by message type, in
+// creation order.
+type store struct {
+	objects map[protoreflect.FullName][]protoreflect.Message
+	nextID  int
+	// files declare the served services, and the message types that methods
+	// only return the id of.
+	files []protoreflect.FileDescriptor
+}
+
+func newStore(files []protoreflect.FileDescriptor) *store {
+	return &store{objects: map[protoreflect.FullName][]protoreflect.Message{}, files: files}
+}
+
+// messageType returns the descriptor of a message type declared in one of the
+// served files, or else in the global registry.
+func (s *store) messageType(name protoreflect.FullName) protoreflect.MessageDescriptor {
+	for _, file := range s.files {
+		if file.Package() == name.Parent() {
+			if msg := file.Messages().ByName(name.Name()); msg != nil {
+				return msg
+			}
+		}
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil
+	}
+	msg, _ := desc.(protoreflect.MessageDescriptor)
+	return msg
+}
+
+// insert stores obj, with a generated id unless it has one and with its
+// creation and update times.
+func (s *store) insert(obj protoreflect.Message) {
+	if idField := obj.Descriptor().Fields().ByName("id"); idField != nil && stringField(obj, "id") == "" {
+		s.nextID++
+		obj.Set(idField, protoreflect.ValueOfString(fmt.Sprintf("%s_fake%06d", snakeCase(string(obj.Descriptor().Name())), s.nextID)))
+	}
+	setTimestamp(obj, "created_at")
+	setTimestamp(obj, "updated_at")
+
+	name := obj.Descriptor().FullName()
+	s.objects[name] = append(s.objects[name], cloneMessage(obj))
+}
+
+func (s *store) all(name protoreflect.FullName) []protoreflect.Message {
+	objects := make([]protoreflect.Message, 0, len(s.objects[name]))
+	for _, obj := range s.objects[name] {
+		objects = append(objects, cloneMessage(obj))
+	}
+	return objects
+}
+
+func (s *store) delete(name protoreflect.FullName, id string) bool {
+	for i, obj := range s.objects[name] {
+		if stringField(obj, "id") == id {
+			s.objects[name] = slices.Delete(s.objects[name], i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// handle serves a method by convention on its name.
+func (s *store) handle(method string, req, res protoreflect.Message) error {
+	switch {
+	case strings.HasPrefix(method, "Create"):
+		return s.create(strings.TrimPrefix(method, "Create"), req, res)
+	case strings.HasPrefix(method, "Update"):
+		return s.update(req, res)
+	case strings.HasPrefix(method, "Delete"):
+		return s.remove(req, res)
+	case strings.HasPrefix(method, "List"), strings.HasPrefix(method, "Search"):
+		return s.list(req, res)
+	default:
+		return s.get(req, res)
+	}
+}
+
+func (s *store) create(typeName string, req, res protoreflect.Message) error {
+	var obj protoreflect.Message
+	field := objectField(res.Descriptor())
+	switch {
+	case field != nil:
+		obj = res.NewField(field).Message()
+	case res.Descriptor().Fields().ByName("id") != nil:
+		// Responses with only the id of the new object, such as
+		// CreateCloudIntegrationResponse, store the type named after the
+		// method.
+		desc := s.messageType(res.Descriptor().ParentFile().Package().Append(protoreflect.Name(typeName)))
+		if desc == nil {
+			return nil
+		}
+		obj = dynamicpb.NewMessage(desc)
+	default:
+		// Nothing to store, e.g. a method triggering an action.
+		return nil
+	}
+
+	copyFields(req, obj)
+	s.insert(obj)
+	if field != nil {
+		res.Set(field, protoreflect.ValueOfMessage(obj))
+	}
+	setStringField(res, "id", stringField(obj, "id"))
+	return nil
+}
+
+func (s *store) get(req, res protoreflect.Message) error {
+	field := objectField(res.Descriptor())
+	if field == nil {
+		// Methods returning derived values, such as an API key or the validity
+		// of a policy, get placeholder values for the object in the request.
+		if id := stringField(req, "id"); id != "" && !s.exists(id) {
+			return notFound(req)
+		}
+		fillPlaceholders(res)
+		return nil
+	}
+
+	obj := s.find(field.Message().FullName(), req)
+	if obj == nil {
+		return notFound(req)
+	}
+	res.Set(field, protoreflect.ValueOfMessage(cloneMessage(obj)))
+	return nil
+}
+
+func (s *store) update(req, res protoreflect.Message) error {
+	field := objectField(res.Descriptor())
+	if field == nil {
+		return nil
+	}
+
+	// Some requests embed the updated object, e.g. UpdateHookRequest.hook.
+	src := req
+	if embedded := embeddedObject(req, field.Message()); embedded != nil {
+		src = embedded
+	}
+
+	obj := s.find(field.Message().FullName(), src)
+	if obj == nil {
+		return notFound(req)
+	}
+	copyFields(src, obj)
+	clearZeroFields(src, obj)
+	setTimestamp(obj, "updated_at")
+	res.Set(field, protoreflect.ValueOfMessage(cloneMessage(obj)))
+	return nil
+}
+
+func (s *store) remove(req, res protoreflect.Message) error {
+	for name, objects := range s.objects {
+		for i, obj := range objects {
+			if matches(obj, req) {
+				s.objects[name] = slices.Delete(objects, i, i+1)
+				if field := objectField(res.Descriptor()); field != nil && field.Message().FullName() == name {
+					res.Set(field, protoreflect.ValueOfMessage(obj))
+				}
+				return nil
+			}
+		}
+	}
+	return notFound(req)
+}
+
+// list returns a page of the stored objects matching the request filter and
+// search. The cursor of the next page is the id of its first object.
+func (s *store) list(req, res protoreflect.Message) error {
+	field := listField(res.Descriptor())
+	if field == nil {
+		return nil
+	}
+
+	var objects []protoreflect.Message
+	for _, obj := range s.objects[field.Message().FullName()] {
+		ok, err := matchesFilter(obj, req)
+		if err != nil {
+			return err
+		}
+		if ok && matchesSearch(obj, req) {
+			objects = append(objects, obj)
+		}
+	}
+
+	if cursor := stringField(req, "cursor"); cursor != "" {
+		start := slices.IndexFunc(objects, func(obj protoreflect.Message) bool { return stringField(obj, "id") == cursor })
+		if start < 0 {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: invalid cursor %q", req.Descriptor().Name(), cursor))
+		}
+		objects = objects[start:]
+	}
+	if limit := intField(req, "limit"); limit > 0 && len(objects) > limit {
+		setStringField(res, "next_cursor", stringField(objects[limit], "id"))
+		objects = objects[:limit]
+	}
+
+	list := res.Mutable(field).List()
+	for _, obj := range objects {
+		list.Append(protoreflect.ValueOfMessage(cloneMessage(obj)))
+	}
+	return nil
+}
+
+// find returns the stored object of the given type with the id of the
+// request, or else matching all the identifying fields of the request.
+func (s *store) find(name protoreflect.FullName, req protoreflect.Message) protoreflect.Message {
+	id := stringField(req, "id")
+	for _, obj := range s.objects[name] {
+		if id != "" && stringField(obj, "id") == id {
+			return obj
+		}
+		if id == "" && matches(obj, req) {
+			return obj
+		}
+	}
+	return nil
+}
+
+func (s *store) exists(id string) bool {
+	for _, objects := range s.objects {
+		for _, obj := range objects {
+			if stringField(obj, "id") == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// embeddedObject returns the message of type obj set in req, if any.
+func embeddedObject(req protoreflect.Message, obj protoreflect.MessageDescriptor) protoreflect.Message {
+	fields := req.Descriptor().Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if field.Kind() == protoreflect.MessageKind && !field.IsList() && !field.IsMap() && field.Message().FullName() == obj.FullName() && req.Has(field) {
+			return req.Get(field).Message()
+		}
+	}
+	return nil
+}
+
+func notFound(req protoreflect.Message) error {
+	return connect.NewError(connect.CodeNotFound, fmt.Errorf("%s: not found", req.Descriptor().Name()))
+}
+
+// objectField returns the first singular message field of a response that
+// isn't a well-known type, e.g. resource in CreateResourceResponse.
+func objectField(res protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	fields := res.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if field.Kind() == protoreflect.MessageKind && !field.IsList() && !field.IsMap() && !isWellKnown(field.Message()) {
+			return field
+		}
+	}
+	return nil
+}
+
+// listField returns the first repeated message field of a response, e.g.
+// resources in ListResourcesResponse.
+func listField(res protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	fields := res.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if field.Kind() == protoreflect.MessageKind && field.IsList() && !isWellKnown(field.Message()) {
+			return field
+		}
+	}
+	return nil
+}
+
+func isWellKnown(msg protoreflect.MessageDescriptor) bool {
+	return msg.ParentFile().Package() == "google.protobuf"
+}
+
+// copyFields sets the fields of dst from the fields set in src with the same
+// name and type:
+//   - a message of src with another type than in dst, such as the gcp options
+//     of CreateCloudIntegrationRequest, is merged into it, where its fields
+//     may also be prefixed with the name of the message, e.g. gcp_project_id;
+//   - a message of src wrapping a single field, such as the tags of
+//     UpdateResourceRequest, sets the field of dst with the same name;
+//   - a <name>_id field of src sets the id of the message of dst it refers
+//     to, see referencedField;
+//   - a <name>_name field of src sets the <name> field of dst if there is no
+//     field with the same name, e.g. database_name.
+func copyFields(src, dst protoreflect.Message) {
+	copyPrefixedFields(src, dst, "")
+}
+
+func copyPrefixedFields(src, dst protoreflect.Message, prefix string) {
+	dstFields := dst.Descriptor().Fields()
+	src.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := field.Name()
+		dstField := dstFields.ByName(name)
+		if dstField == nil && prefix != "" {
+			dstField = dstFields.ByName(protoreflect.Name(prefix + "_" + string(name)))
+		}
+		if dstField != nil {
+			switch {
+			case compatible(field, dstField):
+				dst.Set(dstField, cloneValue(dst, dstField, value))
+			case isSingularMessage(field) && isSingularMessage(dstField) && !isWellKnown(field.Message()) && !isWellKnown(dstField.Message()):
+				copyPrefixedFields(value.Message(), dst.Mutable(dstField).Message(), string(dstField.Name()))
+			case isSingularMessage(field) && field.Message().Fields().Len() == 1:
+				inner := field.Message().Fields().Get(0)
+				if compatible(inner, dstField) {
+					dst.Set(dstField, cloneValue(dst, dstField, value.Message().Get(inner)))
+				}
+			}
+			return true
+		}
+
+		if field.Kind() != protoreflect.StringKind || field.IsList() {
+			return true
+		}
+		if parent := referencedField(dstFields, name); parent != nil {
+			dst.Mutable(parent).Message().Set(parent.Message().Fields().ByName("id"), value)
+			return true
+		}
+		if base, ok := strings.CutSuffix(string(name), "_name"); ok {
+			if target := dstFields.ByName(protoreflect.Name(base)); target != nil && compatible(field, target) {
+				dst.Set(target, value)
+			}
+		}
+		return true
+	})
+}
+
+// clearZeroFields clears the fields of dst that src sets to their zero value.
+// Unlike optional fields, proto3 scalars without presence are always sent, so
+// an update request leaving one unset, e.g. UpdatePolicyRequest with
+// termination_protection turned off, means its zero value.
+func clearZeroFields(src, dst protoreflect.Message) {
+	fields := src.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.HasPresence() || field.IsList() || field.IsMap() || field.Kind() == protoreflect.MessageKind || src.Has(field) {
+			continue
+		}
+		if dstField := dst.Descriptor().Fields().ByName(field.Name()); dstField != nil && compatible(field, dstField) {
+			dst.Clear(dstField)
+		}
+	}
+}
+
+// referencedField returns the message field with an id that a <name>_id field
+// refers to: <name>, or else the longest field named after the end of <name>,
+// e.g. listener for connector_listener_id.
+func referencedField(fields protoreflect.FieldDescriptors, name protoreflect.Name) protoreflect.FieldDescriptor {
+	base, ok := strings.CutSuffix(string(name), "_id")
+	if !ok {
+		return nil
+	}
+	for {
+		if field := fields.ByName(protoreflect.Name(base)); field != nil && isSingularMessage(field) {
+			if idField := field.Message().Fields().ByName("id"); idField != nil && idField.Kind() == protoreflect.StringKind {
+				return field
+			}
+		}
+		_, rest, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil
+		}
+		base = rest
+	}
+}
+
+// refersToSelf reports whether a <name>_id field identifies a message of type
+// msg, because its type name is <name> or ends with it, e.g. hostname_id for
+// ConnectorHostname.
+func refersToSelf(msg protoreflect.MessageDescriptor, name protoreflect.Name) bool {
+	base, ok := strings.CutSuffix(string(name), "_id")
+	if !ok || base == "" {
+		return false
+	}
+	typeName := snakeCase(string(msg.Name()))
+	return typeName == base || strings.HasSuffix(typeName, "_"+base)
+}
+
+func isSingularMessage(field protoreflect.FieldDescriptor) bool {
+	return field.Kind() == protoreflect.MessageKind && !field.IsList() && !field.IsMap()
+}
+
+func compatible(a, b protoreflect.FieldDescriptor) bool {
+	if a.Kind() != b.Kind() || a.IsList() != b.IsList() || a.IsMap() != b.IsMap() {
+		return false
+	}
+	switch a.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return a.Message().FullName() == b.Message().FullName()
+	case protoreflect.EnumKind:
+		return a.Enum().FullName() == b.Enum().FullName()
+	default:
+		return true
+	}
+}
+
+func cloneValue(dst protoreflect.Message, field protoreflect.FieldDescriptor, value protoreflect.Value) protoreflect.Value {
+	switch {
+	case field.IsList():
+		list := dst.NewField(field).List()
+		for i := range value.List().Len() {
+			list.Append(cloneScalarOrMessage(field, value.List().Get(i)))
+		}
+		return protoreflect.ValueOfList(list)
+	case field.IsMap():
+		m := dst.NewField(field).Map()
+		value.Map().Range(func(key protoreflect.MapKey, v protoreflect.Value) bool {
+			m.Set(key, cloneScalarOrMessage(field.MapValue(), v))
+			return true
+		})
+		return protoreflect.ValueOfMap(m)
+	default:
+		return cloneScalarOrMessage(field, value)
+	}
+}
+
+func cloneScalarOrMessage(field protoreflect.FieldDescriptor, value protoreflect.Value) protoreflect.Value {
+	if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+		return protoreflect.ValueOfMessage(cloneMessage(value.Message()))
+	}
+	return value
+}
+
+func cloneMessage(m protoreflect.Message) protoreflect.Message {
+	return proto.Clone(m.Interface()).ProtoReflect()
+}
+
+// matches reports whether the scalar fields set in req identify obj. A
+// <name>_id field of req without a counterpart in obj is compared to the id
+// of the message of obj it refers to, or else to the id of obj itself, as
+// with the hostname_id of GetConnectorHostnameRequest.
+func matches(obj, req protoreflect.Message) bool {
+	compared := 0
+	mismatch := false
+	objFields := obj.Descriptor().Fields()
+	req.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.IsList() || field.IsMap() || field.Kind() == protoreflect.MessageKind {
+			return true
+		}
+		name := field.Name()
+		if objField := objFields.ByName(name); objField != nil && compatible(field, objField) {
+			compared++
+			mismatch = !obj.Get(objField).Equal(value)
+			return !mismatch
+		}
+		if field.Kind() != protoreflect.StringKind {
+			return true
+		}
+		if parent := referencedField(objFields, name); parent != nil {
+			compared++
+			mismatch = stringField(obj.Get(parent).Message(), "id") != value.String()
+			return !mismatch
+		}
+		if refersToSelf(obj.Descriptor(), name) && objFields.ByName("id") != nil {
+			compared++
+			mismatch = stringField(obj, "id") != value.String()
+			return !mismatch
+		}
+		return true
+	})
+	return compared > 0 && !mismatch
+}
+
+// matchesFilter applies the equals filters of a list request, such as
+// Filter{Field: {Key: "name", Operator: "equals", Value: StringValue}}.
+// filterOperators compare the value of a field of an object with the value of
+// a filter.
+var filterOperators = map[string]func(value, want string) bool{
+	"equals":   func(value, want string) bool { return value == want },
+	"contains": strings.Contains,
+}
+
+func matchesFilter(obj, req protoreflect.Message) (bool, error) {
+	filterField := req.Descriptor().Fields().ByName("filter")
+	if filterField == nil || filterField.Kind() != protoreflect.MessageKind || filterField.IsList() || !req.Has(filterField) {
+		return true, nil
+	}
+	filter := req.Get(filterField).Message()
+	fieldField := filter.Descriptor().Fields().ByName("field")
+	if fieldField == nil || fieldField.Kind() != protoreflect.MessageKind || !filter.Has(fieldField) {
+		return true, nil
+	}
+	condition := filter.Get(fieldField).Message()
+
+	key := stringField(condition, "key")
+	if key == "" {
+		return true, nil
+	}
+	operator := stringField(condition, "operator")
+	if operator == "" {
+		operator = "equals"
+	}
+	compare, ok := filterOperators[operator]
+	if !ok {
+		return false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: unsupported filter operator %q", req.Descriptor().Name(), operator))
+	}
+	want, ok := anyStringValue(condition, "value")
+	if !ok {
+		return true, nil
+	}
+
+	value, ok := filteredValue(obj, protoreflect.Name(key))
+	if !ok {
+		return false, nil
+	}
+	return compare(value, want), nil
+}
+
+// filteredValue returns the value of the field of obj a filter key names: a
+// field of obj, the id of the message a <name>_id key refers to, as with the
+// connector_id of a ConnectorListenerLink, or else a field of the message set
+// in a oneof of obj, as with the path of the column of an InventoryObject.
+func filteredValue(obj protoreflect.Message, key protoreflect.Name) (string, bool) {
+	fields := obj.Descriptor().Fields()
+	if field := fields.ByName(key); field != nil {
+		return fmt.Sprint(obj.Get(field).Interface()), true
+	}
+	if parent := referencedField(fields, key); parent != nil {
+		return stringField(obj.Get(parent).Message(), "id"), true
+	}
+	oneofs := obj.Descriptor().Oneofs()
+	for i := range oneofs.Len() {
+		field := obj.WhichOneof(oneofs.Get(i))
+		if field == nil || !isSingularMessage(field) {
+			continue
+		}
+		if inner := field.Message().Fields().ByName(key); inner != nil {
+			return fmt.Sprint(obj.Get(field).Message().Get(inner).Interface()), true
+		}
+	}
+	return "", false
+}
+
+func matchesSearch(obj, req protoreflect.Message) bool {
+	search := stringField(req, "search")
+	if search == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(stringField(obj, "name")), strings.ToLower(search))
+}
+
+// anyStringValue unpacks a google.protobuf.Any field holding a wrapper type.
+func anyStringValue(m protoreflect.Message, name protoreflect.Name) (string, bool) {
+	field := m.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.MessageKind || !m.Has(field) {
+		return "", false
+	}
+	anyValue := m.Get(field).Message()
+	typeURL := stringField(anyValue, "type_url")
+	valueField := anyValue.Descriptor().Fields().ByName("value")
+	if valueField == nil {
+		return "", false
+	}
+	raw := anyValue.Get(valueField).Bytes()
+
+	var wrapper interface {
+		proto.Message
+		GetValue() string
+	}
+	switch {
+	case strings.HasSuffix(typeURL, "google.protobuf.StringValue"):
+		wrapper = &wrapperspb.StringValue{}
+	default:
+		return "", false
+	}
+	if err := proto.Unmarshal(raw, wrapper); err != nil {
+		return "", false
+	}
+	return wrapper.GetValue(), true
+}
+
+// fillPlaceholders sets the string fields of m to a value derived from their
+// name and its bool fields to true.
+func fillPlaceholders(m protoreflect.Message) {
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if field.IsList() || field.IsMap() {
+			continue
+		}
+		switch field.Kind() {
+		case protoreflect.StringKind:
+			m.Set(field, protoreflect.ValueOfString("fake-"+strings.ReplaceAll(string(field.Name()), "_", "-")))
+		case protoreflect.BoolKind:
+			m.Set(field, protoreflect.ValueOfBool(true))
+		}
+	}
+}
+
+func stringField(m protoreflect.Message, name protoreflect.Name) string {
+	field := m.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return ""
+	}
+	return m.Get(field).String()
+}
+
+func setStringField(m protoreflect.Message, name protoreflect.Name, value string) {
+	field := m.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() || value == "" {
+		return
+	}
+	m.Set(field, protoreflect.ValueOfString(value))
+}
+
+func intField(m protoreflect.Message, name protoreflect.Name) int {
+	field := m.Descriptor().Fields().ByName(name)
+	if field == nil || field.IsList() {
+		return 0
+	}
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return int(m.Get(field).Int())
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return int(m.Get(field).Uint())
+	default:
+		return 0
+	}
+}
+
+// setTimestamp sets a google.protobuf.Timestamp field to the current time.
+func setTimestamp(m protoreflect.Message, name protoreflect.Name) {
+	field := m.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.MessageKind || field.IsList() || field.Message().FullName() != "google.protobuf.Timestamp" {
+		return
+	}
+	now := time.Now()
+	ts := m.NewField(field).Message()
+	ts.Set(ts.Descriptor().Fields().ByName("seconds"), protoreflect.ValueOfInt64(now.Unix()))
+	ts.Set(ts.Descriptor().Fields().ByName("nanos"), protoreflect.ValueOfInt32(int32(now.Nanosecond())))
+	m.Set(field, protoreflect.ValueOfMessage(ts))
+}
+
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,43 @@
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+)
+
+// TestCreateRequestFieldsHaveAttributes checks, like the test of the same name
+// in the resources package, that every field of the request sent by Create
+// has a schema attribute.
+func TestCreateRequestFieldsHaveAttributes(t *testing.T) {
+	for _, tc := range []struct {
+		schema  schema.Schema
+		request proto.Message
+		// renamed maps request fields to the differently named attribute
+		// setting them.
+		renamed map[string]string
+	}{
+		{schema: hookSchema(), request: &corev1.CreateHookRequest{}},
+		{schema: policySchema(), request: &corev1.CreatePolicyRequest{}, renamed: map[string]string{"code": "module"}},
+	} {
+		desc := tc.request.ProtoReflect().Descriptor()
+		t.Run(string(desc.Name()), func(t *testing.T) {
+			var missing []string
+			for i := range desc.Fields().Len() {
+				fd := desc.Fields().Get(i)
+				name := string(fd.Name())
+				if attr, ok := tc.renamed[name]; ok {
+					name = attr
+				}
+				if _, ok := tc.schema.Attributes[name]; !ok {
+					missing = append(missing, string(fd.FullName()))
+				}
+			}
+			require.Empty(t, missing, "request fields without a schema attribute")
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package framework
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &connectorAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &connectorAPIKeyEphemeralResource{}
+)
+
+func NewConnectorAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &connectorAPIKeyEphemeralResource{}
+}
+
+type connectorAPIKeyEphemeralResource struct {
+	client *clients.Clients
+}
+
+type connectorAPIKeyModel struct {
+	ConnectorID types.String `tfsdk:"connector_id"`
+	APIKey      types.String `tfsdk:"api_key"`
+}
+
+func (r *connectorAPIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_api_key"
+}
+
+func (r *connectorAPIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the API key of a Connector without storing it in the state, for example to write it to a secrets manager through a write-only argument. It is fetched even if `retrieve_sensitive_values` is set to false.",
+		Attributes: map[string]schema.Attribute{
+			"connector_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Connector.",
+				Required:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "The API key of the Connector.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *connectorAPIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.client = configureClients(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *connectorAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data connectorAPIKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorID := data.ConnectorID.ValueString()
+	res, err := r.client.Grpc.Sdk.ConnectorServiceClient.GetConnectorApiKey(ctx, &corev1.GetConnectorApiKeyRequest{Id: connectorID})
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			resp.Diagnostics.AddError("Unable to fetch connector API key", "no connector found with id "+connectorID)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to fetch connector API key", err.Error())
+		return
+	}
+
+	data.APIKey = types.StringValue(res.Secret)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,78 @@
+package framework
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &machineUserCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &machineUserCredentialsEphemeralResource{}
+)
+
+func NewMachineUserCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &machineUserCredentialsEphemeralResource{}
+}
+
+type machineUserCredentialsEphemeralResource struct {
+	client *clients.Clients
+}
+
+type machineUserCredentialsModel struct {
+	UserID      types.String `tfsdk:"user_id"`
+	AccessToken types.String `tfsdk:"access_token"`
+}
+
+func (r *machineUserCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_user_credentials"
+}
+
+func (r *machineUserCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the access token of a machine User without storing it in the state, for example to write it to a secrets manager through a write-only argument. It is fetched even if `retrieve_sensitive_values` is set to false.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the machine User.",
+				Required:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The access token of the machine User, also exposed as `machine_user_access_token` by `formal_user`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *machineUserCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.client = configureClients(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *machineUserCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data machineUserCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueString()
+	res, err := r.client.Grpc.Sdk.UserServiceClient.GetMachineUserCredentials(ctx, &corev1.GetMachineUserCredentialsRequest{Id: userID})
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			resp.Diagnostics.AddError("Unable to fetch machine user credentials", "no user found with id "+userID)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to fetch machine user credentials", err.Error())
+		return
+	}
+
+	data.AccessToken = types.StringValue(res.Password)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
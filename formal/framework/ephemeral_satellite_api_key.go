@@ -0,0 +1,78 @@
+package framework
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &satelliteAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &satelliteAPIKeyEphemeralResource{}
+)
+
+func NewSatelliteAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &satelliteAPIKeyEphemeralResource{}
+}
+
+type satelliteAPIKeyEphemeralResource struct {
+	client *clients.Clients
+}
+
+type satelliteAPIKeyModel struct {
+	SatelliteID types.String `tfsdk:"satellite_id"`
+	APIKey      types.String `tfsdk:"api_key"`
+}
+
+func (r *satelliteAPIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_satellite_api_key"
+}
+
+func (r *satelliteAPIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the API key of a Satellite without storing it in the state, for example to write it to a secrets manager through a write-only argument. It is fetched even if `retrieve_sensitive_values` is set to false.",
+		Attributes: map[string]schema.Attribute{
+			"satellite_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Satellite.",
+				Required:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "The API key of the Satellite.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *satelliteAPIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.client = configureClients(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *satelliteAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data satelliteAPIKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	satelliteID := data.SatelliteID.ValueString()
+	res, err := r.client.Grpc.Sdk.SatelliteServiceClient.GetSatelliteApiKey(ctx, &corev1.GetSatelliteApiKeyRequest{Id: satelliteID})
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			resp.Diagnostics.AddError("Unable to fetch satellite API key", "no satellite found with id "+satelliteID)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to fetch satellite API key", err.Error())
+		return
+	}
+
+	data.APIKey = types.StringValue(res.ApiKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
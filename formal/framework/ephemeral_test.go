@@ -0,0 +1,115 @@
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/formalco/terraform-provider-formal/formal/api"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestEphemeralResourcesOpen(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		resource  func() ephemeral.EphemeralResource
+		typeName  protoreflect.FullName
+		idAttr    string
+		secret    string
+		procedure string
+		value     string
+	}{
+		{
+			name:      "connector_api_key",
+			resource:  NewConnectorAPIKeyEphemeralResource,
+			typeName:  "core.v1.Connector",
+			idAttr:    "connector_id",
+			secret:    "api_key",
+			procedure: "/core.v1.ConnectorService/GetConnectorApiKey",
+			value:     "fake-secret",
+		},
+		{
+			name:      "satellite_api_key",
+			resource:  NewSatelliteAPIKeyEphemeralResource,
+			typeName:  "core.v1.Satellite",
+			idAttr:    "satellite_id",
+			secret:    "api_key",
+			procedure: "/core.v1.SatelliteService/GetSatelliteApiKey",
+			value:     "fake-api-key",
+		},
+		{
+			name:      "machine_user_credentials",
+			resource:  NewMachineUserCredentialsEphemeralResource,
+			typeName:  "core.v1.User",
+			idAttr:    "user_id",
+			secret:    "access_token",
+			procedure: "/core.v1.UserService/GetMachineUserCredentials",
+			value:     "fake-password",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := fakeapi.NewServer(t)
+			id, err := server.Put(tc.typeName, `{}`)
+			require.NoError(t, err)
+			client, err := api.NewClient(fakeapi.APIKey, false, api.WithBaseURL(server.URL))
+			require.NoError(t, err)
+
+			r := tc.resource()
+			var configureResp ephemeral.ConfigureResponse
+			r.(ephemeral.EphemeralResourceWithConfigure).Configure(t.Context(), ephemeral.ConfigureRequest{ProviderData: &clients.Clients{Grpc: client}}, &configureResp)
+			require.False(t, configureResp.Diagnostics.HasError(), "%v", configureResp.Diagnostics)
+
+			resp := openEphemeral(t, r, map[string]tftypes.Value{tc.idAttr: tftypes.NewValue(tftypes.String, id)})
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.Contains(t, server.Calls(), tc.procedure)
+
+			var values map[string]tftypes.Value
+			require.NoError(t, resp.Result.Raw.As(&values))
+			var value string
+			require.NoError(t, values[tc.secret].As(&value))
+			require.Equal(t, tc.value, value)
+
+			resp = openEphemeral(t, r, map[string]tftypes.Value{tc.idAttr: tftypes.NewValue(tftypes.String, "unknown")})
+			require.True(t, resp.Diagnostics.HasError())
+			require.Contains(t, resp.Diagnostics[0].Detail(), "found with id unknown")
+		})
+	}
+}
+
+// openEphemeral opens r with the given configuration, leaving the computed
+// attributes null.
+func openEphemeral(t *testing.T, r ephemeral.EphemeralResource, config map[string]tftypes.Value) *ephemeral.OpenResponse {
+	t.Helper()
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(t.Context(), ephemeral.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), "%v", schemaResp.Diagnostics)
+
+	objectType := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range config {
+		values[name] = value
+	}
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	r.Open(t.Context(), ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, resp)
+	return resp
+}
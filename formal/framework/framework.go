@@ -0,0 +1,156 @@
+// Package framework implements the resources of the Formal provider migrated
+// from terraform-plugin-sdk/v2 to terraform-plugin-framework. They are served
+// next to the SDKv2 ones through terraform-plugin-mux, so resources can move
+// over one at a time without changing their state. It also implements the
+// ephemeral resources, list resources and provider-defined functions, which
+// terraform-plugin-sdk/v2 doesn't support.
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+// Resources returns the constructors of the resources implemented with
+// terraform-plugin-framework.
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewHookResource,
+		NewPolicyResource,
+	}
+}
+
+// EphemeralResources returns the constructors of the ephemeral resources.
+func EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewConnectorAPIKeyEphemeralResource,
+		NewMachineUserCredentialsEphemeralResource,
+		NewSatelliteAPIKeyEphemeralResource,
+	}
+}
+
+// ListResources returns the constructors of the list resources, with which
+// terraform query lists the instances of a resource to import.
+func ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewNativeUserListResource,
+		NewPolicyListResource,
+		NewResourceListResource,
+	}
+}
+
+// Functions returns the constructors of the provider-defined functions, called
+// as provider::formal::<name>.
+func Functions() []func() function.Function {
+	return []func() function.Function{
+		NewHumanIdentityFunction,
+		NewInventoryPathFunction,
+		NewValidateCronFunction,
+	}
+}
+
+// configureClients returns the clients the provider was configured with, or
+// nil if it isn't configured yet, for example during validation.
+func configureClients(providerData any, diags *diag.Diagnostics) *clients.Clients {
+	if providerData == nil {
+		return nil
+	}
+	c, ok := providerData.(*clients.Clients)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *clients.Clients, got %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil
+	}
+	return c
+}
+
+// checkReadOnly adds an error to diags and returns false when the provider is
+// in read_only mode, before any request is made. It mirrors the guard of the
+// SDKv2 resources.
+func checkReadOnly(c *clients.Clients, operation, typeName string, diags *diag.Diagnostics) bool {
+	if c != nil && c.ReadOnly {
+		diags.AddError(
+			"The Formal provider is in read-only mode",
+			fmt.Sprintf("Cannot %s %s because read_only is set to true in the provider configuration.", operation, typeName),
+		)
+		return false
+	}
+	return true
+}
+
+// timestampValue formats a timestamp of the API as RFC 3339, like the SDKv2
+// resources do.
+func timestampValue(ts *timestamppb.Timestamp) types.String {
+	if ts == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(ts.AsTime().UTC().Format(time.RFC3339))
+}
+
+// stringSetValue converts a list of strings returned by the API to a set. An
+// empty list stays null when prior is null, as the SDKv2 stores an optional
+// set missing from the configuration.
+func stringSetValue(ctx context.Context, values []string, prior types.Set) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// stringSetElements returns the elements of a set of strings.
+func stringSetElements(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var values []string
+	if set.IsNull() || set.IsUnknown() {
+		return values, nil
+	}
+	diags := set.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// decodeRawState decodes a state written with a previous schema version.
+func decodeRawState(raw *tfprotov6.RawState) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if raw == nil || raw.JSON == nil {
+		diags.AddError("Unable to upgrade resource state", "The state is empty.")
+		return nil, diags
+	}
+	var state map[string]any
+	if err := json.Unmarshal(raw.JSON, &state); err != nil {
+		diags.AddError("Unable to upgrade resource state", err.Error())
+		return nil, diags
+	}
+	return state, diags
+}
+
+// encodeRawState encodes an upgraded state, dropping the attributes which are
+// no longer in the schema.
+func encodeRawState(state map[string]any, s schema.Schema) (*tfprotov6.DynamicValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	for attr := range state {
+		if _, ok := s.Attributes[attr]; !ok {
+			delete(state, attr)
+		}
+	}
+	raw, err := json.Marshal(state)
+	if err != nil {
+		diags.AddError("Unable to upgrade resource state", err.Error())
+		return nil, diags
+	}
+	return &tfprotov6.DynamicValue{JSON: raw}, diags
+}
@@ -0,0 +1,51 @@
+package framework
+
+import (
+	"context"
+	"net/mail"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/formalco/terraform-provider-formal/formal/api"
+)
+
+var _ function.Function = &humanIdentityFunction{}
+
+func NewHumanIdentityFunction() function.Function {
+	return &humanIdentityFunction{}
+}
+
+type humanIdentityFunction struct{}
+
+func (f *humanIdentityFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "human_identity"
+}
+
+func (f *humanIdentityFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the identity of a human User",
+		MarkdownDescription: "Returns the identity of the human User with the given email, for example `idp:formal:human:jane@example.com`, as found in the `db_username` of a `formal_user`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "email",
+				MarkdownDescription: "The email of the human User.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *humanIdentityFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var email string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &email))
+	if resp.Error != nil {
+		return
+	}
+
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		resp.Error = function.NewArgumentFuncError(0, "email must be a plain email address, for example jane@example.com")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, api.HumanIdentityPrefix+email))
+}
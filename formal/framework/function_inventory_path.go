@@ -0,0 +1,83 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &inventoryPathFunction{}
+
+func NewInventoryPathFunction() function.Function {
+	return &inventoryPathFunction{}
+}
+
+type inventoryPathFunction struct{}
+
+func (f *inventoryPathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "inventory_path"
+}
+
+func (f *inventoryPathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the path of an inventory object",
+		MarkdownDescription: "Returns the `path` of a `formal_inventory_object`, for example `mydb.public.users.email`. Pass `null` for the trailing segments to build the path of a database, schema or table.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "db",
+				MarkdownDescription: "The name of the database.",
+			},
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "The name of the schema, or `null` for the path of the database.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "table",
+				MarkdownDescription: "The name of the table, or `null` for the path of the schema.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "column",
+				MarkdownDescription: "The name of the column, or `null` for the path of the table.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *inventoryPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var db string
+	var schema, table, column types.String
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &db, &schema, &table, &column))
+	if resp.Error != nil {
+		return
+	}
+
+	segments := []string{db}
+	end := false
+	for i, segment := range []types.String{schema, table, column} {
+		if segment.IsNull() {
+			end = true
+			continue
+		}
+		if end {
+			resp.Error = function.NewArgumentFuncError(int64(i+1), "cannot be set when a previous segment is null")
+			return
+		}
+		segments = append(segments, segment.ValueString())
+	}
+
+	for i, segment := range segments {
+		if segment == "" || strings.Contains(segment, ".") {
+			resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf("%q must be a non-empty name without dots", segment))
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(segments, ".")))
+}
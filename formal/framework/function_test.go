@@ -0,0 +1,111 @@
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunctions(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		function  func() function.Function
+		arguments []attr.Value
+		want      string
+		wantErr   string
+	}{
+		{
+			name:      "human_identity",
+			function:  NewHumanIdentityFunction,
+			arguments: []attr.Value{types.StringValue("jane@example.com")},
+			want:      "idp:formal:human:jane@example.com",
+		},
+		{
+			name:      "human_identity invalid email",
+			function:  NewHumanIdentityFunction,
+			arguments: []attr.Value{types.StringValue("Jane <jane@example.com>")},
+			wantErr:   "plain email address",
+		},
+		{
+			name:      "validate_cron standard",
+			function:  NewValidateCronFunction,
+			arguments: []attr.Value{types.StringValue("0 4,16 * * *"), types.BoolValue(false)},
+			want:      "0 4,16 * * *",
+		},
+		{
+			name:      "validate_cron predefined schedule",
+			function:  NewValidateCronFunction,
+			arguments: []attr.Value{types.StringValue("6h"), types.BoolValue(false)},
+			want:      "6h",
+		},
+		{
+			name:      "validate_cron seconds",
+			function:  NewValidateCronFunction,
+			arguments: []attr.Value{types.StringValue("*/10 * * * * *"), types.BoolValue(true)},
+			want:      "*/10 * * * * *",
+		},
+		{
+			name:      "validate_cron missing seconds",
+			function:  NewValidateCronFunction,
+			arguments: []attr.Value{types.StringValue("0 4 * * *"), types.BoolValue(true)},
+			wantErr:   "not a valid cron expression",
+		},
+		{
+			name:      "validate_cron predefined schedule with seconds",
+			function:  NewValidateCronFunction,
+			arguments: []attr.Value{types.StringValue("6h"), types.BoolValue(true)},
+			wantErr:   "not a valid cron expression",
+		},
+		{
+			name:      "validate_cron invalid",
+			function:  NewValidateCronFunction,
+			arguments: []attr.Value{types.StringValue("every day"), types.BoolValue(false)},
+			wantErr:   "not a valid cron expression",
+		},
+		{
+			name:      "inventory_path column",
+			function:  NewInventoryPathFunction,
+			arguments: []attr.Value{types.StringValue("mydb"), types.StringValue("public"), types.StringValue("users"), types.StringValue("email")},
+			want:      "mydb.public.users.email",
+		},
+		{
+			name:      "inventory_path table",
+			function:  NewInventoryPathFunction,
+			arguments: []attr.Value{types.StringValue("mydb"), types.StringValue("public"), types.StringValue("users"), types.StringNull()},
+			want:      "mydb.public.users",
+		},
+		{
+			name:      "inventory_path db",
+			function:  NewInventoryPathFunction,
+			arguments: []attr.Value{types.StringValue("mydb"), types.StringNull(), types.StringNull(), types.StringNull()},
+			want:      "mydb",
+		},
+		{
+			name:      "inventory_path gap",
+			function:  NewInventoryPathFunction,
+			arguments: []attr.Value{types.StringValue("mydb"), types.StringNull(), types.StringValue("users"), types.StringNull()},
+			wantErr:   "previous segment is null",
+		},
+		{
+			name:      "inventory_path dot",
+			function:  NewInventoryPathFunction,
+			arguments: []attr.Value{types.StringValue("mydb"), types.StringValue("public.users"), types.StringNull(), types.StringNull()},
+			wantErr:   "without dots",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			tc.function().Run(t.Context(), function.RunRequest{Arguments: function.NewArgumentsData(tc.arguments)}, &resp)
+			if tc.wantErr != "" {
+				require.NotNil(t, resp.Error)
+				require.Contains(t, resp.Error.Error(), tc.wantErr)
+				return
+			}
+			require.Nil(t, resp.Error)
+			require.Equal(t, types.StringValue(tc.want), resp.Result.Value())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	resources "github.com/formalco/terraform-provider-formal/formal/resources"
+)
+
+var _ function.Function = &validateCronFunction{}
+
+func NewValidateCronFunction() function.Function {
+	return &validateCronFunction{}
+}
+
+type validateCronFunction struct{}
+
+func (f *validateCronFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_cron"
+}
+
+func (f *validateCronFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validate a cron expression",
+		MarkdownDescription: "Returns the cron expression unchanged if it is valid, and fails otherwise. Use it to check the `schedule` of a `formal_data_discovery` (`seconds = false`, for example `0 4,16 * * *` or one of the predefined schedules `6h`, `12h`, `18h` and `24h`) or the `worker_schedule` of a `formal_policy_data_loader` (`seconds = true`, for example `*/10 * * * * *`) before applying.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expr",
+				MarkdownDescription: "The cron expression.",
+			},
+			function.BoolParameter{
+				Name:                "seconds",
+				MarkdownDescription: "Whether the expression starts with a seconds field, making it six fields long instead of five.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *validateCronFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expr string
+	var seconds bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &expr, &seconds))
+	if resp.Error != nil {
+		return
+	}
+
+	validate := resources.ValidateSchedule
+	if seconds {
+		validate = resources.ValidateWorkerSchedule
+	}
+	if err := validate(expr); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid cron expression: %s", expr, err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, expr))
+}
@@ -0,0 +1,100 @@
+package framework
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+// listResults converts objects to list results up to the limit of the
+// request. result returns false for an object to skip, for example one
+// deleted since it was listed.
+func listResults[T any](req list.ListRequest, objects iter.Seq2[T, error], summary string, result func(T) (list.ListResult, bool)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		for object, err := range objects {
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError(summary, err.Error())
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			res, ok := result(object)
+			if !ok {
+				continue
+			}
+			count++
+			if !push(res) {
+				return
+			}
+		}
+	}
+}
+
+// sdkRawV5Schemas returns the schemas of a resource implemented with
+// terraform-plugin-sdk/v2, which the framework needs to list its instances.
+func sdkRawV5Schemas(ctx context.Context, r *sdkschema.Resource, resp *list.RawV5SchemaResponse) {
+	resp.ProtoV5Schema = r.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = r.ProtoIdentitySchema(ctx)()
+}
+
+// sdkListResult returns the list result of the instance of a resource
+// implemented with terraform-plugin-sdk/v2 with the given ID. Its identity
+// must be its ID. When the request includes the resource, its Read fills it,
+// and sdkListResult returns false if it no longer exists.
+func sdkListResult(ctx context.Context, req list.ListRequest, r *sdkschema.Resource, c *clients.Clients, id, displayName string) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	d := r.Data(&terraform.InstanceState{ID: id, Attributes: map[string]string{"id": id}})
+	identity, err := d.Identity()
+	if err == nil {
+		err = identity.Set("id", id)
+	}
+	if err != nil {
+		result.Diagnostics.AddError("Unable to set resource identity", err.Error())
+		return result, true
+	}
+
+	if req.IncludeResource {
+		for _, diagnostic := range r.ReadContext(ctx, d, c) {
+			if diagnostic.Severity == sdkdiag.Error {
+				result.Diagnostics.AddError(diagnostic.Summary, diagnostic.Detail)
+			} else {
+				result.Diagnostics.AddWarning(diagnostic.Summary, diagnostic.Detail)
+			}
+		}
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+		if d.Id() == "" {
+			return result, false
+		}
+
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Unable to convert resource state", err.Error())
+			return result, true
+		}
+		result.Resource.Raw = *state
+	}
+
+	identityState, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Unable to convert resource identity", err.Error())
+		return result, true
+	}
+	result.Identity.Raw = *identityState
+
+	return result, true
+}
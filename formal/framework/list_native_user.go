@@ -0,0 +1,86 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+	resources "github.com/formalco/terraform-provider-formal/formal/resources"
+)
+
+var (
+	_ list.ListResource                 = &nativeUserListResource{}
+	_ list.ListResourceWithConfigure    = &nativeUserListResource{}
+	_ list.ListResourceWithRawV5Schemas = &nativeUserListResource{}
+)
+
+func NewNativeUserListResource() list.ListResource {
+	return &nativeUserListResource{resource: resources.ResourceNativeUser()}
+}
+
+// nativeUserListResource lists the Native Users of the organization, which
+// are implemented with terraform-plugin-sdk/v2.
+type nativeUserListResource struct {
+	resource *sdkschema.Resource
+	client   *clients.Clients
+}
+
+type nativeUserListModel struct {
+	ResourceID types.String `tfsdk:"resource_id"`
+}
+
+func (r *nativeUserListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_native_user"
+}
+
+func (r *nativeUserListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the Native Users of the organization, for example to import them with `terraform query -generate-config-out`. Their secrets aren't listed: set `native_user_secret_wo` in the generated configuration before applying it.",
+		Attributes: map[string]listschema.Attribute{
+			"resource_id": listschema.StringAttribute{
+				MarkdownDescription: "Only list the Native Users of the Resource with this ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *nativeUserListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	sdkRawV5Schemas(ctx, r.resource, resp)
+}
+
+func (r *nativeUserListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureClients(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *nativeUserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config nativeUserListModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	objects := paging.All(ctx, func(ctx context.Context, cursor string) ([]*corev1.NativeUser, string, error) {
+		res, err := r.client.Grpc.Sdk.ResourceServiceClient.ListNativeUsers(ctx, &corev1.ListNativeUsersRequest{
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.NativeUsers, res.NextCursor, nil
+	})
+	stream.Results = listResults(req, objects, "Unable to list native users", func(object *corev1.NativeUser) (list.ListResult, bool) {
+		if resourceID := config.ResourceID.ValueString(); resourceID != "" && object.ResourceId != resourceID {
+			return list.ListResult{}, false
+		}
+		return sdkListResult(ctx, req, r.resource, r.client, object.Id, object.Username)
+	})
+}
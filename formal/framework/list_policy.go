@@ -0,0 +1,67 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+var (
+	_ list.ListResource              = &policyListResource{}
+	_ list.ListResourceWithConfigure = &policyListResource{}
+)
+
+func NewPolicyListResource() list.ListResource {
+	return &policyListResource{}
+}
+
+type policyListResource struct {
+	client *clients.Clients
+}
+
+func (r *policyListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *policyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists every Policy of the organization, for example to import them with `terraform query -generate-config-out`.",
+	}
+}
+
+func (r *policyListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureClients(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *policyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	objects := paging.All(ctx, func(ctx context.Context, cursor string) ([]*corev1.Policy, string, error) {
+		res, err := r.client.Grpc.Sdk.PoliciesServiceClient.ListPolicies(ctx, &corev1.ListPoliciesRequest{
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Policies, res.NextCursor, nil
+	})
+	stream.Results = listResults(req, objects, "Unable to list policies", func(policy *corev1.Policy) (list.ListResult, bool) {
+		result := req.NewListResult(ctx)
+		result.DisplayName = policy.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, policyIdentityModel{ID: types.StringValue(policy.Id)})...)
+		if req.IncludeResource {
+			// The listed policies are complete, so unlike the SDKv2 list
+			// resources, including them doesn't read each of them again.
+			var model policyModel
+			flattenPolicy(policy, &model)
+			result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+		}
+		return result, true
+	})
+}
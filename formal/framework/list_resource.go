@@ -0,0 +1,66 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+	resources "github.com/formalco/terraform-provider-formal/formal/resources"
+)
+
+var (
+	_ list.ListResource                 = &resourceListResource{}
+	_ list.ListResourceWithConfigure    = &resourceListResource{}
+	_ list.ListResourceWithRawV5Schemas = &resourceListResource{}
+)
+
+func NewResourceListResource() list.ListResource {
+	return &resourceListResource{resource: resources.ResourceResource()}
+}
+
+// resourceListResource lists the Resources of the organization, which are
+// implemented with terraform-plugin-sdk/v2.
+type resourceListResource struct {
+	resource *sdkschema.Resource
+	client   *clients.Clients
+}
+
+func (r *resourceListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+func (r *resourceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists every Resource of the organization, for example to import them with `terraform query -generate-config-out`.",
+	}
+}
+
+func (r *resourceListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	sdkRawV5Schemas(ctx, r.resource, resp)
+}
+
+func (r *resourceListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureClients(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *resourceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	objects := paging.All(ctx, func(ctx context.Context, cursor string) ([]*corev1.Resource, string, error) {
+		res, err := r.client.Grpc.Sdk.ResourceServiceClient.ListResources(ctx, &corev1.ListResourcesRequest{
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Resources, res.NextCursor, nil
+	})
+	stream.Results = listResults(req, objects, "Unable to list resources", func(object *corev1.Resource) (list.ListResult, bool) {
+		return sdkListResult(ctx, req, r.resource, r.client, object.Id, object.Name)
+	})
+}
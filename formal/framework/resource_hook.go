@@ -0,0 +1,308 @@
+package framework
+
+import (
+	"context"
+	"regexp"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+var (
+	_ resource.Resource                = &hookResource{}
+	_ resource.ResourceWithConfigure   = &hookResource{}
+	_ resource.ResourceWithImportState = &hookResource{}
+)
+
+var hookIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func NewHookResource() resource.Resource {
+	return &hookResource{}
+}
+
+type hookResource struct {
+	client *clients.Clients
+}
+
+type hookModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	Description                     types.String `tfsdk:"description"`
+	Code                            types.String `tfsdk:"code"`
+	Status                          types.String `tfsdk:"status"`
+	TimeoutMs                       types.Int64  `tfsdk:"timeout_ms"`
+	AllowlistedEnvironmentVariables types.Set    `tfsdk:"allowlisted_environment_variables"`
+	AllowlistedNetworkHosts         types.Set    `tfsdk:"allowlisted_network_hosts"`
+	CreatedAt                       types.String `tfsdk:"created_at"`
+	UpdatedAt                       types.String `tfsdk:"updated_at"`
+}
+
+func (r *hookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hook"
+}
+
+func (r *hookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = hookSchema()
+}
+
+func hookSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Hooks are JavaScript functions evaluated during policy decisions. Policies reference hooks as `input.hooks.<name>`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the hook.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the hook. Must be unique within the organization and match `^[A-Za-z_][A-Za-z0-9_]*$`. Policies reference this name as `input.hooks.<name>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hookIdentifierRegexp, "name must match ^[A-Za-z_][A-Za-z0-9_]*$"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The hook description.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "The hook implementation as JavaScript. Must be a default-exported function (for example `export default function hook(input, env) { ... }`). The optional second argument receives allowlisted process environment variables.",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The hook status. Accepted values are `active` and `draft`. Only active hooks can be referenced by policies.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("draft"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "draft"),
+				},
+			},
+			"timeout_ms": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in milliseconds the hook may run during policy evaluation. Must be between 1 and 60000.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5000),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60000),
+				},
+			},
+			"allowlisted_environment_variables": schema.SetAttribute{
+				MarkdownDescription: "Names of process environment variables the hook may read via its second `env` argument at evaluation time. Each name must match `^[A-Za-z_][A-Za-z0-9_]*$`. Variables that are unset on the connector or desktop process are omitted from `env`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(hookIdentifierRegexp, "environment variable name must match ^[A-Za-z_][A-Za-z0-9_]*$")),
+				},
+			},
+			"allowlisted_network_hosts": schema.SetAttribute{
+				MarkdownDescription: "Hostnames, IP addresses, and CIDR ranges the hook may contact at evaluation time. Schemes, paths, and ports are not accepted. All ports on each host are allowed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the hook was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the hook was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *hookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureClients(req.ProviderData, &resp.Diagnostics)
+}
+
+// expand returns the hook described by model.
+func (m *hookModel) expand(ctx context.Context) (*corev1.Hook, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	allowlistedEnv, d := stringSetElements(ctx, m.AllowlistedEnvironmentVariables)
+	diags.Append(d...)
+	allowlistedNetworkHosts, d := stringSetElements(ctx, m.AllowlistedNetworkHosts)
+	diags.Append(d...)
+
+	return &corev1.Hook{
+		Id:                              m.ID.ValueString(),
+		Name:                            m.Name.ValueString(),
+		Description:                     m.Description.ValueString(),
+		Code:                            m.Code.ValueString(),
+		Status:                          m.Status.ValueString(),
+		TimeoutMs:                       int32(m.TimeoutMs.ValueInt64()),
+		AllowlistedEnvironmentVariables: allowlistedEnv,
+		AllowlistedNetworkHosts:         allowlistedNetworkHosts,
+	}, diags
+}
+
+func (r *hookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkReadOnly(r.client, "create", "formal_hook", &resp.Diagnostics) {
+		return
+	}
+
+	var plan hookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, diags := plan.expand(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Grpc.Sdk.HookServiceClient.CreateHook(ctx, &corev1.CreateHookRequest{
+		Name:                            hook.Name,
+		Description:                     hook.Description,
+		Code:                            hook.Code,
+		Status:                          hook.Status,
+		TimeoutMs:                       hook.TimeoutMs,
+		AllowlistedEnvironmentVariables: hook.AllowlistedEnvironmentVariables,
+		AllowlistedNetworkHosts:         hook.AllowlistedNetworkHosts,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create hook", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(res.Hook.Id)
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *hookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state hookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read refreshes model from the API. It returns false if the hook no longer
+// exists.
+func (r *hookResource) read(ctx context.Context, model *hookModel, diags *diag.Diagnostics) bool {
+	hookID := model.ID.ValueString()
+
+	res, err := r.client.Grpc.Sdk.HookServiceClient.GetHook(ctx, &corev1.GetHookRequest{Id: hookID})
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			tflog.Warn(ctx, "The Hook with ID "+hookID+" was not found, which means it may have been deleted without using this Terraform config.", map[string]any{"err": err})
+			return false
+		}
+		diags.AddError("Unable to read hook", err.Error())
+		return true
+	}
+
+	hook := res.Hook
+	model.ID = types.StringValue(hook.Id)
+	model.Name = types.StringValue(hook.Name)
+	model.Description = types.StringValue(hook.Description)
+	model.Code = types.StringValue(hook.Code)
+	model.Status = types.StringValue(hook.Status)
+	model.TimeoutMs = types.Int64Value(int64(hook.TimeoutMs))
+	model.CreatedAt = timestampValue(hook.CreatedAt)
+	model.UpdatedAt = timestampValue(hook.UpdatedAt)
+
+	var d diag.Diagnostics
+	model.AllowlistedEnvironmentVariables, d = stringSetValue(ctx, hook.AllowlistedEnvironmentVariables, model.AllowlistedEnvironmentVariables)
+	diags.Append(d...)
+	model.AllowlistedNetworkHosts, d = stringSetValue(ctx, hook.AllowlistedNetworkHosts, model.AllowlistedNetworkHosts)
+	diags.Append(d...)
+
+	return true
+}
+
+func (r *hookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkReadOnly(r.client, "update", "formal_hook", &resp.Diagnostics) {
+		return
+	}
+
+	var plan hookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, diags := plan.expand(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Grpc.Sdk.HookServiceClient.UpdateHook(ctx, &corev1.UpdateHookRequest{Hook: hook})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update hook", err.Error())
+		return
+	}
+
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *hookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkReadOnly(r.client, "delete", "formal_hook", &resp.Diagnostics) {
+		return
+	}
+
+	var state hookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Grpc.Sdk.HookServiceClient.DeleteHook(ctx, &corev1.DeleteHookRequest{Id: state.ID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete hook", err.Error())
+	}
+}
+
+func (r *hookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,360 @@
+package framework
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+var (
+	_ resource.Resource                 = &policyResource{}
+	_ resource.ResourceWithConfigure    = &policyResource{}
+	_ resource.ResourceWithIdentity     = &policyResource{}
+	_ resource.ResourceWithImportState  = &policyResource{}
+	_ resource.ResourceWithModifyPlan   = &policyResource{}
+	_ resource.ResourceWithUpgradeState = &policyResource{}
+)
+
+func NewPolicyResource() resource.Resource {
+	return &policyResource{}
+}
+
+type policyResource struct {
+	client *clients.Clients
+}
+
+type policyModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	Module                types.String `tfsdk:"module"`
+	Status                types.String `tfsdk:"status"`
+	TerminationProtection types.Bool   `tfsdk:"termination_protection"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
+}
+
+// policyIdentityModel is the identity of a policy, with which Terraform imports
+// the policies it lists.
+type policyIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *policyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = policySchema()
+}
+
+func policySchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Creating a Policy in Formal.",
+		Version:             2,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Policy Name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Policy Description.",
+				Required:            true,
+			},
+			"module": schema.StringAttribute{
+				MarkdownDescription: "The module describing how the policy works. Create one in the Formal Console.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of this Policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the policy was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update time.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Defines the current status of the policy. It can be one of the following: 'draft', 'dry-run', or 'active'.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("draft", "dry-run", "active"),
+				},
+			},
+			"termination_protection": schema.BoolAttribute{
+				MarkdownDescription: "If set to true, this Policy cannot be deleted.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *policyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the Policy.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *policyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureClients(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *policyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan policyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Module.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state policyModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || plan.Module.Equal(state.Module) {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Validating policy code", map[string]any{
+		"id": plan.ID.ValueString(),
+	})
+
+	res, err := r.client.Grpc.Sdk.PoliciesServiceClient.GetPolicyCodeValidity(ctx, &corev1.GetPolicyCodeValidityRequest{
+		Code: plan.Module.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Policy code validation failed", err.Error())
+		return
+	}
+	if !res.Valid {
+		resp.Diagnostics.AddAttributeError(path.Root("module"), "Invalid policy code", res.Error)
+		return
+	}
+	tflog.Debug(ctx, "Policy code validation successful")
+}
+
+func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkReadOnly(r.client, "create", "formal_policy", &resp.Diagnostics) {
+		return
+	}
+
+	var plan policyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Grpc.Sdk.PoliciesServiceClient.CreatePolicy(ctx, &corev1.CreatePolicyRequest{
+		Name:                  plan.Name.ValueString(),
+		Description:           plan.Description.ValueString(),
+		Code:                  plan.Module.ValueString(),
+		Status:                plan.Status.ValueString(),
+		TerminationProtection: plan.TerminationProtection.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create policy", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(res.Policy.Id)
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, policyIdentityModel{ID: plan.ID})...)
+}
+
+func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, policyIdentityModel{ID: state.ID})...)
+}
+
+// read refreshes model from the API. It returns false if the policy no longer
+// exists.
+func (r *policyResource) read(ctx context.Context, model *policyModel, diags *diag.Diagnostics) bool {
+	policyID := model.ID.ValueString()
+
+	res, err := r.client.Grpc.Sdk.PoliciesServiceClient.GetPolicy(ctx, &corev1.GetPolicyRequest{Id: policyID})
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			tflog.Warn(ctx, "The Policy with ID "+policyID+" was not found, which means it may have been deleted without using this Terraform config.", map[string]any{"err": err})
+			return false
+		}
+		diags.AddError("Unable to read policy", err.Error())
+		return true
+	}
+
+	flattenPolicy(res.Policy, model)
+	return true
+}
+
+// flattenPolicy sets the attributes of model from policy.
+func flattenPolicy(policy *corev1.Policy, model *policyModel) {
+	model.ID = types.StringValue(policy.Id)
+	model.Name = types.StringValue(policy.Name)
+	model.Description = types.StringValue(policy.Description)
+	model.Module = types.StringValue(policy.Code)
+	model.Status = types.StringValue(policy.Status)
+	model.TerminationProtection = types.BoolValue(policy.TerminationProtection)
+	model.CreatedAt = timestampValue(policy.CreatedAt)
+	model.UpdatedAt = timestampValue(policy.UpdatedAt)
+}
+
+func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkReadOnly(r.client, "update", "formal_policy", &resp.Diagnostics) {
+		return
+	}
+
+	var plan policyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Grpc.Sdk.PoliciesServiceClient.UpdatePolicy(ctx, &corev1.UpdatePolicyRequest{
+		Id:                    plan.ID.ValueString(),
+		Name:                  plan.Name.ValueString(),
+		Description:           plan.Description.ValueString(),
+		Code:                  plan.Module.ValueString(),
+		Status:                plan.Status.ValueString(),
+		TerminationProtection: plan.TerminationProtection.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update policy", err.Error())
+		return
+	}
+
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, policyIdentityModel{ID: plan.ID})...)
+}
+
+func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkReadOnly(r.client, "delete", "formal_policy", &resp.Diagnostics) {
+		return
+	}
+
+	var state policyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.TerminationProtection.ValueBool() {
+		resp.Diagnostics.AddError("Unable to delete policy", "Policy cannot be deleted because termination_protection is set to true")
+		return
+	}
+
+	_, err := r.client.Grpc.Sdk.PoliciesServiceClient.DeletePolicy(ctx, &corev1.DeletePolicyRequest{Id: state.ID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete policy", err.Error())
+	}
+}
+
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// UpgradeState upgrades the states written by the SDKv2 implementation. Unlike
+// the SDKv2, the framework upgrades a state straight to the current version,
+// so each upgrader also applies the ones of the later versions.
+func (r *policyResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: r.upgradeStateV0},
+		1: {StateUpgrader: r.upgradeStateV1},
+	}
+}
+
+// upgradeStateV0 reads the status of the policy, which version 0 didn't have,
+// from the API.
+func (r *policyResource) upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	state, diags := decodeRawState(req.RawState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if id, ok := state["id"].(string); ok {
+		if r.client == nil {
+			resp.Diagnostics.AddError("Unable to upgrade policy state", "The provider must be configured to read the status of the policy.")
+			return
+		}
+		res, err := r.client.Grpc.Sdk.PoliciesServiceClient.GetPolicy(ctx, &corev1.GetPolicyRequest{Id: id})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to upgrade policy state", err.Error())
+			return
+		}
+		state["status"] = res.Policy.Status
+	}
+
+	resp.DynamicValue, diags = encodeRawState(state, policySchema())
+	resp.Diagnostics.Append(diags...)
+}
+
+// upgradeStateV1 drops owner and notification, which were removed in version
+// 2.
+func (r *policyResource) upgradeStateV1(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	state, diags := decodeRawState(req.RawState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.DynamicValue, diags = encodeRawState(state, policySchema())
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,50 @@
+package framework
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/require"
+
+	"github.com/formalco/terraform-provider-formal/formal/api"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+// TestPolicyResourceUpgradeState upgrades the state fixtures of the schema
+// versions written by the SDKv2 implementation, and expects the same states as
+// its upgraders.
+func TestPolicyResourceUpgradeState(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	_, err := server.Put("core.v1.Policy", `{"id": "policy_golden", "status": "active"}`)
+	require.NoError(t, err)
+	client, err := api.NewClient(fakeapi.APIKey, false, api.WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	r := &policyResource{client: &clients.Clients{Grpc: client}}
+	stateType := policySchema().Type().TerraformType(t.Context())
+
+	dir := filepath.Join("testdata", "state_upgraders", "formal_policy")
+	for version, upgrader := range r.UpgradeState(t.Context()) {
+		t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+			raw, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("v%d.json", version)))
+			require.NoError(t, err)
+
+			var resp resource.UpgradeStateResponse
+			upgrader.StateUpgrader(t.Context(), resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: raw}}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.NotNil(t, resp.DynamicValue)
+
+			_, err = resp.DynamicValue.Unmarshal(stateType)
+			require.NoError(t, err, "upgraded state doesn't match the schema")
+
+			want, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("v%d.golden.json", version)))
+			require.NoError(t, err)
+			require.JSONEq(t, string(want), string(resp.DynamicValue.JSON))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package framework
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSDKv2States checks that the resources migrated from SDKv2 read the
+// states their SDKv2 implementation wrote: testdata/sdkv2_states holds a
+// state of each, with the attributes and schema version of the last SDKv2
+// release.
+func TestSDKv2States(t *testing.T) {
+	for typeName, r := range map[string]resource.Resource{
+		"formal_hook":   NewHookResource(),
+		"formal_policy": NewPolicyResource(),
+	} {
+		t.Run(typeName, func(t *testing.T) {
+			raw, err := os.ReadFile(filepath.Join("testdata", "sdkv2_states", typeName+".json"))
+			require.NoError(t, err)
+			var state struct {
+				SchemaVersion int64           `json:"schema_version"`
+				Attributes    json.RawMessage `json:"attributes"`
+			}
+			require.NoError(t, json.Unmarshal(raw, &state))
+
+			var resp resource.SchemaResponse
+			r.Schema(t.Context(), resource.SchemaRequest{}, &resp)
+			require.Equal(t, state.SchemaVersion, resp.Schema.Version)
+
+			var attributes map[string]any
+			require.NoError(t, json.Unmarshal(state.Attributes, &attributes))
+			require.ElementsMatch(t, slices.Collect(maps.Keys(resp.Schema.Attributes)), slices.Collect(maps.Keys(attributes)))
+
+			_, err = (&tfprotov6.RawState{JSON: state.Attributes}).Unmarshal(resp.Schema.Type().TerraformType(t.Context()))
+			require.NoError(t, err, "state doesn't match the schema")
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestListResource_resource(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	id := testAccPut(t, server, "core.v1.Resource", `{"name": "payments", "technology": "postgres", "hostname": "postgres.example.com", "port": 5432}`)
+
+	results := testListResource(t, server, "formal_resource", nil)
+	require.Len(t, results, 1)
+	require.Equal(t, "payments", results[0].displayName)
+	require.Equal(t, map[string]string{"id": id}, results[0].identity)
+	require.Equal(t, "postgres.example.com", results[0].resource["hostname"])
+	require.Equal(t, "postgres", results[0].resource["technology"])
+}
+
+func TestListResource_policy(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	id := testAccPut(t, server, "core.v1.Policy", `{"name": "block-pii", "code": "package formal.v2", "status": "active"}`)
+
+	results := testListResource(t, server, "formal_policy", nil)
+	require.Len(t, results, 1)
+	require.Equal(t, "block-pii", results[0].displayName)
+	require.Equal(t, map[string]string{"id": id}, results[0].identity)
+	require.Equal(t, "package formal.v2", results[0].resource["module"])
+	require.Equal(t, "active", results[0].resource["status"])
+}
+
+func TestListResource_nativeUser(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	id := testAccPut(t, server, "core.v1.NativeUser", `{"resourceId": "resource_payments", "username": "payments_reader"}`)
+	testAccPut(t, server, "core.v1.NativeUser", `{"resourceId": "resource_billing", "username": "billing_reader"}`)
+
+	results := testListResource(t, server, "formal_native_user", nil)
+	require.Len(t, results, 2)
+
+	results = testListResource(t, server, "formal_native_user", map[string]tftypes.Value{
+		"resource_id": tftypes.NewValue(tftypes.String, "resource_payments"),
+	})
+	require.Len(t, results, 1)
+	require.Equal(t, "payments_reader", results[0].displayName)
+	require.Equal(t, map[string]string{"id": id}, results[0].identity)
+	require.Equal(t, "resource_payments", results[0].resource["resource_id"])
+}
+
+type testListResult struct {
+	displayName string
+	identity    map[string]string
+	// resource holds the string attributes of the listed resource.
+	resource map[string]string
+}
+
+// testListResource lists the instances of typeName with the list block
+// configuration config, as terraform query does with the resources included,
+// and fails on any error diagnostic.
+func testListResource(t *testing.T, server *fakeapi.Server, typeName string, config map[string]tftypes.Value) []testListResult {
+	t.Helper()
+
+	providerServer, err := NewProviderServer(t.Context(), "test")
+	require.NoError(t, err)
+	// The list resource RPCs are on a separate interface until they're
+	// stable.
+	p, ok := providerServer().(tfprotov5.ProviderServerWithListResource)
+	require.True(t, ok)
+
+	schemas, err := p.GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	identitySchemas, err := p.GetResourceIdentitySchemas(t.Context(), &tfprotov5.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+
+	providerConfig := testNullObject(schemas.Provider.ValueType(), map[string]tftypes.Value{
+		"api_key":  tftypes.NewValue(tftypes.String, fakeapi.APIKey),
+		"base_url": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	configureResp, err := p.ConfigureProvider(t.Context(), &tfprotov5.ConfigureProviderRequest{Config: testDynamicValue(t, providerConfig)})
+	require.NoError(t, err)
+	testNoErrorDiagnostics(t, configureResp.Diagnostics)
+
+	listSchema := schemas.ListResourceSchemas[typeName]
+	require.NotNil(t, listSchema)
+	stream, err := p.ListResource(t.Context(), &tfprotov5.ListResourceRequest{
+		TypeName:        typeName,
+		Config:          testDynamicValue(t, testNullObject(listSchema.ValueType(), config)),
+		IncludeResource: true,
+	})
+	require.NoError(t, err)
+
+	var results []testListResult
+	for result := range stream.Results {
+		testNoErrorDiagnostics(t, result.Diagnostics)
+
+		identity, err := result.Identity.IdentityData.Unmarshal(identitySchemas.IdentitySchemas[typeName].ValueType())
+		require.NoError(t, err)
+		resource, err := result.Resource.Unmarshal(schemas.ResourceSchemas[typeName].ValueType())
+		require.NoError(t, err)
+
+		results = append(results, testListResult{
+			displayName: result.DisplayName,
+			identity:    testStringAttributes(t, identity),
+			resource:    testStringAttributes(t, resource),
+		})
+	}
+	return results
+}
+
+// testNullObject returns an object of type objectType with the given
+// attributes, and the others null.
+func testNullObject(objectType tftypes.Type, attributes map[string]tftypes.Value) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.(tftypes.Object).AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func testDynamicValue(t *testing.T, value tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	dynamicValue, err := tfprotov5.NewDynamicValue(value.Type(), value)
+	require.NoError(t, err)
+	return &dynamicValue
+}
+
+func testStringAttributes(t *testing.T, object tftypes.Value) map[string]string {
+	t.Helper()
+
+	var values map[string]tftypes.Value
+	require.NoError(t, object.As(&values))
+	attributes := map[string]string{}
+	for name, value := range values {
+		if !value.Type().Is(tftypes.String) || value.IsNull() {
+			continue
+		}
+		var s string
+		require.NoError(t, value.As(&s))
+		attributes[name] = s
+	}
+	return attributes
+}
+
+func testNoErrorDiagnostics(t *testing.T, diags []*tfprotov5.Diagnostic) {
+	t.Helper()
+
+	for _, diag := range diags {
+		require.NotEqual(t, tfprotov5.DiagnosticSeverityError, diag.Severity, "%s: %s", diag.Summary, diag.Detail)
+	}
+}
@@ -0,0 +1,68 @@
+// Package paging pages through the objects listed by the Formal API, which
+// returns a cursor with each page until the last one.
+package paging
+
+import (
+	"context"
+	"iter"
+)
+
+// PageSize is the number of objects requested per page.
+const PageSize = 100
+
+// Page lists one page of objects, and returns the cursor of the next page,
+// which is empty after the last page.
+type Page[T any] func(ctx context.Context, cursor string) (objects []T, next string, err error)
+
+// All returns the objects of every page, requesting the next page only once
+// the objects of the previous one are consumed. It stops after yielding the
+// error of a page.
+func All[T any](ctx context.Context, page Page[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		cursor := ""
+		for {
+			objects, next, err := page(ctx, cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, object := range objects {
+				if !yield(object, nil) {
+					return
+				}
+			}
+			if next == "" || len(objects) == 0 {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// Collect returns the objects of every page.
+func Collect[T any](ctx context.Context, page Page[T]) ([]T, error) {
+	var objects []T
+	for object, err := range All(ctx, page) {
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// Find returns the first object for which match returns true, and whether
+// there's one, without requesting the pages after it.
+func Find[T any](ctx context.Context, page Page[T], match func(T) bool) (T, bool, error) {
+	var zero T
+	for object, err := range All(ctx, page) {
+		if err != nil {
+			return zero, false, err
+		}
+		if match(object) {
+			return object, true, nil
+		}
+	}
+	return zero, false, nil
+}
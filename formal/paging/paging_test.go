@@ -0,0 +1,67 @@
+package paging
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testPages returns a Page serving pages of the given objects, and the
+// cursors it was called with.
+func testPages(pages ...[]int) (Page[int], *[]string) {
+	var cursors []string
+	return func(_ context.Context, cursor string) ([]int, string, error) {
+		cursors = append(cursors, cursor)
+		i := 0
+		if cursor != "" {
+			i, _ = strconv.Atoi(cursor)
+		}
+		next := ""
+		if i+1 < len(pages) {
+			next = strconv.Itoa(i + 1)
+		}
+		return pages[i], next, nil
+	}, &cursors
+}
+
+func TestCollect(t *testing.T) {
+	page, cursors := testPages([]int{1, 2}, []int{3}, []int{4, 5})
+
+	objects, err := Collect(t.Context(), page)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, objects)
+	require.Equal(t, []string{"", "1", "2"}, *cursors)
+}
+
+func TestCollectStopsOnEmptyPage(t *testing.T) {
+	page, cursors := testPages([]int{1}, []int{}, []int{2})
+
+	objects, err := Collect(t.Context(), page)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, objects)
+	require.Equal(t, []string{"", "1"}, *cursors)
+}
+
+func TestCollectError(t *testing.T) {
+	_, err := Collect(t.Context(), func(context.Context, string) ([]int, string, error) {
+		return nil, "", errors.New("unavailable")
+	})
+	require.EqualError(t, err, "unavailable")
+}
+
+func TestFind(t *testing.T) {
+	page, cursors := testPages([]int{1, 2}, []int{3}, []int{4, 5})
+
+	object, ok, err := Find(t.Context(), page, func(i int) bool { return i == 3 })
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 3, object)
+	require.Equal(t, []string{"", "1"}, *cursors, "Find must not request the pages after the match")
+
+	_, ok, err = Find(t.Context(), page, func(i int) bool { return i == 6 })
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const defaultProfile = "default"
+
+// credentialsProfile holds the settings of one section of the credentials file.
+type credentialsProfile struct {
+	APIKey  string
+	BaseURL string
+}
+
+// credentialsFilePath returns FORMAL_CREDENTIALS_FILE, or ~/.formal/credentials.
+func credentialsFilePath() (string, error) {
+	if path := os.Getenv("FORMAL_CREDENTIALS_FILE"); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the Formal credentials file: %w", err)
+	}
+	return filepath.Join(home, ".formal", "credentials"), nil
+}
+
+// resolveProfile loads the profile named by the profile attribute or
+// FORMAL_PROFILE. When neither is set, the default profile is used if the
+// credentials file has one, and an empty profile otherwise.
+func resolveProfile(name string) (credentialsProfile, error) {
+	explicit := true
+	if name == "" {
+		name = os.Getenv("FORMAL_PROFILE")
+	}
+	if name == "" {
+		name = defaultProfile
+		explicit = false
+	}
+
+	path, err := credentialsFilePath()
+	if err != nil {
+		if explicit {
+			return credentialsProfile{}, err
+		}
+		return credentialsProfile{}, nil
+	}
+
+	profiles, err := loadCredentialsFile(path)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return credentialsProfile{}, nil
+		}
+		return credentialsProfile{}, err
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		if explicit {
+			return credentialsProfile{}, fmt.Errorf("profile %q not found in %s", name, path)
+		}
+		return credentialsProfile{}, nil
+	}
+	return profile, nil
+}
+
+// loadCredentialsFile parses an INI credentials file such as:
+//
+//	[default]
+//	api_key = ...
+//
+//	[eu]
+//	api_key  = ...
+//	base_url = https://...
+func loadCredentialsFile(path string) (map[string]credentialsProfile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open Formal credentials file: %w", err)
+	}
+	defer f.Close()
+
+	profiles := map[string]credentialsProfile{}
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("%s:%d: invalid section header %q", path, lineNumber, line)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == "" {
+				return nil, fmt.Errorf("%s:%d: empty section name", path, lineNumber)
+			}
+			if _, ok := profiles[section]; !ok {
+				profiles[section] = credentialsProfile{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, lineNumber)
+		}
+		if section == "" {
+			return nil, fmt.Errorf("%s:%d: %q is outside of a profile section", path, lineNumber, strings.TrimSpace(key))
+		}
+		key = strings.TrimSpace(key)
+		value = unquote(strings.TrimSpace(value))
+
+		profile := profiles[section]
+		switch key {
+		case "api_key":
+			profile.APIKey = value
+		case "base_url":
+			profile.BaseURL = value
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown key %q", path, lineNumber, key)
+		}
+		profiles[section] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read Formal credentials file: %w", err)
+	}
+
+	return profiles, nil
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testCredentialsFile = `# Formal credentials
+[default]
+api_key = default-key
+
+[eu]
+api_key  = "eu-key"
+base_url = https://formal-api.example.com
+`
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	t.Setenv("FORMAL_CREDENTIALS_FILE", path)
+	return path
+}
+
+func TestResolveProfile(t *testing.T) {
+	writeCredentialsFile(t, testCredentialsFile)
+
+	t.Run("default profile", func(t *testing.T) {
+		t.Setenv("FORMAL_PROFILE", "")
+
+		profile, err := resolveProfile("")
+		require.NoError(t, err)
+		require.Equal(t, credentialsProfile{APIKey: "default-key"}, profile)
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv("FORMAL_PROFILE", "eu")
+
+		profile, err := resolveProfile("")
+		require.NoError(t, err)
+		require.Equal(t, credentialsProfile{APIKey: "eu-key", BaseURL: "https://formal-api.example.com"}, profile)
+	})
+
+	t.Run("provider attribute takes precedence", func(t *testing.T) {
+		t.Setenv("FORMAL_PROFILE", "eu")
+
+		profile, err := resolveProfile("default")
+		require.NoError(t, err)
+		require.Equal(t, "default-key", profile.APIKey)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, err := resolveProfile("us")
+		require.ErrorContains(t, err, `profile "us" not found`)
+	})
+}
+
+func TestResolveProfileWithoutCredentialsFile(t *testing.T) {
+	t.Setenv("FORMAL_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("FORMAL_PROFILE", "")
+
+	profile, err := resolveProfile("")
+	require.NoError(t, err)
+	require.Empty(t, profile)
+
+	_, err = resolveProfile("eu")
+	require.Error(t, err)
+}
+
+func TestLoadCredentialsFileRejectsInvalidContent(t *testing.T) {
+	for name, content := range map[string]string{
+		"key outside section": "api_key = key\n",
+		"unknown key":         "[default]\ntoken = key\n",
+		"missing separator":   "[default]\napi_key\n",
+		"unclosed section":    "[default\napi_key = key\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := loadCredentialsFile(writeCredentialsFile(t, content))
+			require.Error(t, err)
+		})
+	}
+}
@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/formalco/terraform-provider-formal/formal/api"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
@@ -24,22 +29,170 @@ func New(version string) func() *schema.Provider {
 		p := &schema.Provider{
 			Schema: map[string]*schema.Schema{
 				"api_key": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Description:   "API key used to authenticate to the Formal API. Can also be set with the `FORMAL_API_KEY` environment variable or read from a credentials profile.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"oidc"},
+				},
+				"oidc": {
+					Description:   "Beta: authenticate by exchanging an OIDC token issued to a CI workload, such as a GitHub Actions or GitLab job, instead of using a long-lived API key. The token must be trusted by a `formal_integration_oidc` integration.",
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"api_key"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"integration_id": {
+								Description: "ID of the `formal_integration_oidc` integration trusting the token.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"token": {
+								Description:   "OIDC token to exchange. Can also be set with the `FORMAL_OIDC_TOKEN` environment variable.",
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"oidc.0.token_file"},
+							},
+							"token_file": {
+								Description:   "Path to a file containing the OIDC token to exchange.",
+								Type:          schema.TypeString,
+								Optional:      true,
+								ConflictsWith: []string{"oidc.0.token"},
+							},
+						},
+					},
+				},
+				"base_url": {
+					Description:  "URL of the Formal API, for example to target a regional or single-tenant control plane. Can also be set with the `FORMAL_BASE_URL` environment variable. Defaults to the Formal production API.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateBaseURL,
+				},
+				"ca_cert_pem": {
+					Description: "PEM encoded certificate authorities trusted in addition to the system ones when connecting to the Formal API, for example the CA of a TLS-intercepting egress proxy.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"client_cert_pem": {
+					Description:  "PEM encoded client certificate presented to the Formal API or egress proxy for mutual TLS.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{"client_key_pem"},
+				},
+				"client_key_pem": {
+					Description:  "PEM encoded private key of `client_cert_pem`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					RequiredWith: []string{"client_cert_pem"},
+				},
+				"proxy_url": {
+					Description:  "URL of the proxy used to reach the Formal API. Defaults to the `HTTPS_PROXY` and `HTTP_PROXY` environment variables.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				},
+				"insecure_skip_verify": {
+					Description: "Skip verification of the Formal API TLS certificate. Only use this for testing.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"default_space_id": {
+					Description: "ID of the Space in which `formal_resource`, `formal_connector` and `formal_satellite` resources without a `space_id` are created. Without it, they are created in the root of the organization. Existing resources aren't moved to this Space.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"default_tags": {
+					Description: "Tags applied to every Formal resource supporting tags, such as `formal_resource`. Tags set on a resource take precedence over default tags with the same key.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tags": {
+								Description: "Tags to apply to every resource.",
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				"profile": {
+					Description: "Name of the profile to read the API key and base URL from in the `~/.formal/credentials` file, or the file set with the `FORMAL_CREDENTIALS_FILE` environment variable. Can also be set with the `FORMAL_PROFILE` environment variable. Settings from the provider block and environment variables take precedence over the profile.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"read_only": {
+					Description: "If set to true, the provider only reads from the Formal API: creating, updating or deleting a resource fails before any request is made. Use it to run `terraform plan` with credentials that must never modify anything.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
 				},
 				"retrieve_sensitive_values": {
-					Type:     schema.TypeBool,
-					Optional: true,
-					Default:  true,
+					Description: "If set to false, sensitive values such as API keys and certificates are not retrieved from the Formal API and are left empty in the state.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+				},
+				"max_retries": {
+					Description:  "Maximum number of times a request to the Formal API is retried when it is rate limited, unavailable or timed out. Requests modifying objects are only retried when they were rate limited or failed to connect. Set to 0 to disable retries.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      api.DefaultMaxRetries,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_max_wait": {
+					Description:  "Maximum number of seconds to wait between two attempts of a retried request.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      int(api.DefaultRetryMaxWait.Seconds()),
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Description:  "Maximum average number of requests per second sent to the Formal API, to stay below server-side rate limits on large applies. Set to 0, the default, for no limit.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"max_concurrent_requests": {
+					Description:  "Maximum number of requests to the Formal API in flight at the same time. Set to 0, the default, for no limit.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"formal_connector": datasources.Connector(),
-				"formal_group":     datasources.Group(),
-				"formal_resource":  datasources.Resource(),
-				"formal_space":     datasources.Space(),
-				"formal_user":      datasources.User(),
+				"formal_connector":         datasources.Connector(),
+				"formal_connectors":        datasources.Connectors(),
+				"formal_encryption_key":    datasources.EncryptionKey(),
+				"formal_form":              datasources.Form(),
+				"formal_group":             datasources.Group(),
+				"formal_group_members":     datasources.GroupMembers(),
+				"formal_groups":            datasources.Groups(),
+				"formal_hook":              datasources.Hook(),
+				"formal_integration_cloud": datasources.IntegrationCloud(),
+				"formal_integration_log":   datasources.IntegrationLog(),
+				"formal_native_user":       datasources.NativeUser(),
+				"formal_permission":        datasources.Permission(),
+				"formal_policy":            datasources.Policy(),
+				"formal_resource":          datasources.Resource(),
+				"formal_resources":         datasources.Resources(),
+				"formal_satellite":         datasources.Satellite(),
+				"formal_space":             datasources.Space(),
+				"formal_spaces":            datasources.Spaces(),
+				"formal_user":              datasources.User(),
+				"formal_users":             datasources.Users(),
+				"formal_workflow":          datasources.Workflow(),
 			},
+			// Resources implemented with terraform-plugin-framework, such as
+			// formal_policy and formal_hook, are in the framework package.
 			ResourcesMap: map[string]*schema.Resource{
 				"formal_connector":                         resource.ResourceConnector(),
 				"formal_connector_ai_provider":             resource.ResourceConnectorAiProvider(),
@@ -51,12 +204,10 @@ func New(version string) func() *schema.Provider {
 				"formal_connector_listener_link":           resource.ResourceConnectorListenerLink(),
 				"formal_connector_satellite_link":          resource.ResourceConnectorSatelliteLink(),
 				"formal_permission":                        resource.ResourcePermission(),
-				"formal_policy":                            resource.ResourcePolicy(),
 				"formal_policy_data_loader":                resource.ResourcePolicyDataLoader(),
 				"formal_group":                             resource.ResourceGroup(),
 				"formal_group_user_link":                   resource.ResourceGroupLinkUser(),
 				"formal_form":                              resource.ResourceForm(),
-				"formal_hook":                              resource.ResourceHook(),
 				"formal_resource":                          resource.ResourceResource(),
 				"formal_native_user":                       resource.ResourceNativeUser(),
 				"formal_native_user_link":                  resource.ResourceNativeUserLink(),
@@ -88,6 +239,10 @@ func New(version string) func() *schema.Provider {
 			},
 		}
 
+		for typeName, r := range p.ResourcesMap {
+			guardReadOnly(typeName, r)
+		}
+
 		p.ConfigureContextFunc = configure(version, p)
 
 		return p
@@ -96,20 +251,185 @@ func New(version string) func() *schema.Provider {
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (any, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		returnSensitiveValue := d.Get("retrieve_sensitive_values").(bool)
+		maxRetries := d.Get("max_retries").(int)
+		retryMaxWait := time.Duration(d.Get("retry_max_wait").(int)) * time.Second
+
+		profile, err := resolveProfile(d.Get("profile").(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		baseURL, diags := resolveBaseURL(d, profile)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		httpClient, err := api.NewHTTPClient(api.TransportConfig{
+			CACertPEM:          d.Get("ca_cert_pem").(string),
+			ClientCertPEM:      d.Get("client_cert_pem").(string),
+			ClientKeyPEM:       d.Get("client_key_pem").(string),
+			ProxyURL:           d.Get("proxy_url").(string),
+			InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		})
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+		if d.Get("insecure_skip_verify").(bool) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "TLS certificate verification of the Formal API is disabled",
+				Detail:   "insecure_skip_verify is set to true. Set ca_cert_pem instead to trust a custom certificate authority.",
+			})
+		}
+
 		apiKey := d.Get("api_key").(string)
+		if oidc, ok := d.GetOk("oidc"); ok && apiKey == "" {
+			config := oidc.([]any)[0].(map[string]any)
+			token, err := resolveOIDCToken(config)
+			if err != nil {
+				return nil, append(diags, diag.FromErr(err)...)
+			}
+			apiKey, err = api.ExchangeOIDCToken(ctx, httpClient, baseURL, config["integration_id"].(string), token)
+			if err != nil {
+				return nil, append(diags, diag.FromErr(err)...)
+			}
+		}
 		if apiKey == "" {
 			apiKey = os.Getenv("FORMAL_API_KEY")
+		}
+		if apiKey == "" {
+			apiKey = profile.APIKey
 			if apiKey == "" {
-				return nil, diag.Errorf("api_key must be set in the provider or as an environment variable")
+				return nil, append(diags, diag.Errorf("api_key must be set in the provider, as an environment variable or in a credentials profile")...)
 			}
 		}
-		returnSensitiveValue := d.Get("retrieve_sensitive_values").(bool)
 
-		grpc, err := api.NewClient(apiKey, returnSensitiveValue)
+		opts := []api.Option{
+			api.WithRetry(maxRetries, retryMaxWait),
+			api.WithRateLimit(d.Get("requests_per_second").(int), d.Get("max_concurrent_requests").(int)),
+			api.WithReadOnly(d.Get("read_only").(bool)),
+			api.WithHTTPClient(httpClient),
+		}
+		if baseURL != "" {
+			opts = append(opts, api.WithBaseURL(baseURL))
+		}
+
+		grpc, err := api.NewClient(apiKey, returnSensitiveValue, opts...)
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+
+		return &clients.Clients{
+			Grpc:           grpc,
+			DefaultTags:    expandDefaultTags(d),
+			DefaultSpaceID: d.Get("default_space_id").(string),
+			ReadOnly:       d.Get("read_only").(bool),
+		}, diags
+	}
+}
+
+func expandDefaultTags(d *schema.ResourceData) map[string]string {
+	defaultTags := map[string]string{}
+	if tags, ok := d.GetOk("default_tags.0.tags"); ok {
+		for key, value := range tags.(map[string]any) {
+			defaultTags[key] = value.(string)
+		}
+	}
+	return defaultTags
+}
+
+// resolveOIDCToken returns the OIDC token from the oidc block, reading it from
+// token_file if set, and falls back to FORMAL_OIDC_TOKEN.
+func resolveOIDCToken(config map[string]any) (string, error) {
+	if token := config["token"].(string); token != "" {
+		return token, nil
+	}
+
+	if path := config["token_file"].(string); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read OIDC token file: %w", err)
+		}
+		token := strings.TrimSpace(string(content))
+		if token == "" {
+			return "", fmt.Errorf("OIDC token file %s is empty", path)
+		}
+		return token, nil
+	}
+
+	if token := os.Getenv("FORMAL_OIDC_TOKEN"); token != "" {
+		return token, nil
+	}
+
+	return "", fmt.Errorf("oidc requires token, token_file or the FORMAL_OIDC_TOKEN environment variable")
+}
+
+// resolveBaseURL returns the Formal API URL from the provider block, then
+// FORMAL_BASE_URL, then the deprecated FORMAL_ENV=dev and FORMAL_DEV_URL pair,
+// then the credentials profile. An empty URL means the SDK default.
+func resolveBaseURL(d *schema.ResourceData, profile credentialsProfile) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if baseURL := d.Get("base_url").(string); baseURL != "" {
+		return baseURL, diags
+	}
+
+	if baseURL := os.Getenv("FORMAL_BASE_URL"); baseURL != "" {
+		if _, errs := validateBaseURL(baseURL, "FORMAL_BASE_URL"); len(errs) > 0 {
+			return "", diag.FromErr(errs[0])
 		}
+		return baseURL, diags
+	}
+
+	if os.Getenv("FORMAL_ENV") == "dev" {
+		baseURL := os.Getenv("FORMAL_DEV_URL")
+		if baseURL == "" {
+			return "", diag.Errorf("FORMAL_ENV=dev requires FORMAL_DEV_URL")
+		}
+		if _, errs := validateBaseURL(baseURL, "FORMAL_DEV_URL"); len(errs) > 0 {
+			return "", diag.FromErr(errs[0])
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "FORMAL_ENV and FORMAL_DEV_URL are deprecated",
+			Detail:   "Set the base_url provider attribute or the FORMAL_BASE_URL environment variable instead.",
+		})
+		return baseURL, diags
+	}
 
-		return &clients.Clients{Grpc: grpc}, nil
+	if profile.BaseURL != "" {
+		if _, errs := validateBaseURL(profile.BaseURL, "profile base_url"); len(errs) > 0 {
+			return "", diag.FromErr(errs[0])
+		}
+		return profile.BaseURL, diags
+	}
+
+	return "", diags
+}
+
+func validateBaseURL(v any, key string) (warns []string, errs []error) {
+	raw, ok := v.(string)
+	if !ok || strings.TrimSpace(raw) == "" {
+		errs = append(errs, fmt.Errorf("%q must be a non-empty string", key))
+		return warns, errs
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		errs = append(errs, fmt.Errorf("%q must be a valid absolute URL", key))
+		return warns, errs
+	}
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		errs = append(errs, fmt.Errorf("%q scheme must be https or http", key))
+		return warns, errs
+	}
+	if parsed.User != nil {
+		errs = append(errs, fmt.Errorf("%q must not include user credentials", key))
+		return warns, errs
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		errs = append(errs, fmt.Errorf("%q must not include a query or a fragment", key))
+		return warns, errs
 	}
+	return warns, errs
 }
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/framework"
+)
+
+// NewProviderServer returns the provider server muxing the SDKv2 provider with
+// the terraform-plugin-framework one, which serves the resources migrated to
+// the framework.
+func NewProviderServer(ctx context.Context, version string) (func() tfprotov5.ProviderServer, error) {
+	primary := New(version)()
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		func() tfprotov5.ProviderServer {
+			return planWarningsServer{ProviderServer: primary.GRPCProvider(), primary: primary}
+		},
+		providerserver.NewProtocol5(NewFramework(version, primary)()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// NewFramework returns the terraform-plugin-framework provider muxed with
+// primary. It doesn't configure its own clients: the mux server configures
+// primary first, and the framework provider reuses its clients.
+func NewFramework(version string, primary *schema.Provider) func() fwprovider.Provider {
+	return func() fwprovider.Provider {
+		return &frameworkProvider{
+			version: version,
+			primary: primary,
+		}
+	}
+}
+
+type frameworkProvider struct {
+	version string
+	primary *schema.Provider
+}
+
+var (
+	_ fwprovider.Provider                       = &frameworkProvider{}
+	_ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions          = &frameworkProvider{}
+	_ fwprovider.ProviderWithListResources      = &frameworkProvider{}
+)
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "formal"
+	resp.Version = p.version
+}
+
+// Schema must be identical to the schema of the SDKv2 provider in New, or the
+// mux server refuses to serve both.
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	// Reuse the descriptions of the SDKv2 schema so that they can't diverge.
+	sdkSchema := p.primary.Schema
+	oidc := sdkSchema["oidc"].Elem.(*schema.Resource).Schema
+	defaultTags := sdkSchema["default_tags"].Elem.(*schema.Resource).Schema
+
+	resp.Schema = fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"api_key": fwschema.StringAttribute{
+				MarkdownDescription: sdkSchema["api_key"].Description,
+				Optional:            true,
+			},
+			"base_url": fwschema.StringAttribute{
+				MarkdownDescription: sdkSchema["base_url"].Description,
+				Optional:            true,
+			},
+			"ca_cert_pem": fwschema.StringAttribute{
+				MarkdownDescription: sdkSchema["ca_cert_pem"].Description,
+				Optional:            true,
+			},
+			"client_cert_pem": fwschema.StringAttribute{
+				MarkdownDescription: sdkSchema["client_cert_pem"].Description,
+				Optional:            true,
+			},
+			"client_key_pem": fwschema.StringAttribute{
+				MarkdownDescription: sdkSchema["client_key_pem"].Description,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"proxy_url": fwschema.StringAttribute{
+				MarkdownDescription: sdkSchema["proxy_url"].Description,
+				Optional:            true,
+			},
+			"insecure_skip_verify": fwschema.BoolAttribute{
+				MarkdownDescription: sdkSchema["insecure_skip_verify"].Description,
+				Optional:            true,
+			},
+			"default_space_id": fwschema.StringAttribute{
+				MarkdownDescription: sdkSchema["default_space_id"].Description,
+				Optional:            true,
+			},
+			"profile": fwschema.StringAttribute{
+				MarkdownDescription: sdkSchema["profile"].Description,
+				Optional:            true,
+			},
+			"read_only": fwschema.BoolAttribute{
+				MarkdownDescription: sdkSchema["read_only"].Description,
+				Optional:            true,
+			},
+			"retrieve_sensitive_values": fwschema.BoolAttribute{
+				MarkdownDescription: sdkSchema["retrieve_sensitive_values"].Description,
+				Optional:            true,
+			},
+			"max_retries": fwschema.Int64Attribute{
+				MarkdownDescription: sdkSchema["max_retries"].Description,
+				Optional:            true,
+			},
+			"retry_max_wait": fwschema.Int64Attribute{
+				MarkdownDescription: sdkSchema["retry_max_wait"].Description,
+				Optional:            true,
+			},
+			"requests_per_second": fwschema.Int64Attribute{
+				MarkdownDescription: sdkSchema["requests_per_second"].Description,
+				Optional:            true,
+			},
+			"max_concurrent_requests": fwschema.Int64Attribute{
+				MarkdownDescription: sdkSchema["max_concurrent_requests"].Description,
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]fwschema.Block{
+			"oidc": fwschema.ListNestedBlock{
+				MarkdownDescription: sdkSchema["oidc"].Description,
+				NestedObject: fwschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"integration_id": fwschema.StringAttribute{
+							MarkdownDescription: oidc["integration_id"].Description,
+							Required:            true,
+						},
+						"token": fwschema.StringAttribute{
+							MarkdownDescription: oidc["token"].Description,
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"token_file": fwschema.StringAttribute{
+							MarkdownDescription: oidc["token_file"].Description,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"default_tags": fwschema.ListNestedBlock{
+				MarkdownDescription: sdkSchema["default_tags"].Description,
+				NestedObject: fwschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": fwschema.MapAttribute{
+							MarkdownDescription: defaultTags["tags"].Description,
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure hands the clients of the SDKv2 provider to the framework
+// resources. The SDKv2 provider validated the configuration and reported any
+// error already, in which case the mux server doesn't configure this one.
+func (p *frameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	c, ok := p.primary.Meta().(*clients.Clients)
+	if !ok {
+		return
+	}
+	resp.DataSourceData = c
+	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
+}
+
+func (p *frameworkProvider) Resources(context.Context) []func() fwresource.Resource {
+	return framework.Resources()
+}
+
+func (p *frameworkProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return framework.EphemeralResources()
+}
+
+func (p *frameworkProvider) ListResources(context.Context) []func() list.ListResource {
+	return framework.ListResources()
+}
+
+func (p *frameworkProvider) Functions(context.Context) []func() function.Function {
+	return framework.Functions()
+}
+
+func (p *frameworkProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
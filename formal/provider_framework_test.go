@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProviderServer(t *testing.T) {
+	providerServer, err := NewProviderServer(t.Context(), "dev")
+	require.NoError(t, err)
+
+	// The mux server reports an error if the SDKv2 and framework provider
+	// schemas differ, or if both serve the same resource.
+	res, err := providerServer().GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for _, diag := range res.Diagnostics {
+		require.NotEqual(t, tfprotov5.DiagnosticSeverityError, diag.Severity, "%s: %s", diag.Summary, diag.Detail)
+	}
+
+	require.Contains(t, res.ResourceSchemas, "formal_policy")
+	require.Contains(t, res.ResourceSchemas, "formal_hook")
+	require.Contains(t, res.ResourceSchemas, "formal_permission")
+	require.Equal(t, int64(2), res.ResourceSchemas["formal_policy"].Version)
+	require.Contains(t, res.EphemeralResourceSchemas, "formal_connector_api_key")
+	require.Contains(t, res.EphemeralResourceSchemas, "formal_machine_user_credentials")
+	require.Contains(t, res.EphemeralResourceSchemas, "formal_satellite_api_key")
+	require.Contains(t, res.ListResourceSchemas, "formal_native_user")
+	require.Contains(t, res.ListResourceSchemas, "formal_policy")
+	require.Contains(t, res.ListResourceSchemas, "formal_resource")
+	require.Contains(t, res.Functions, "human_identity")
+	require.Contains(t, res.Functions, "inventory_path")
+	require.Contains(t, res.Functions, "validate_cron")
+}
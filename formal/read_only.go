@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+// guardReadOnly makes the Create, Update and Delete functions of r fail when
+// the provider is in read_only mode, before any request is made.
+func guardReadOnly(typeName string, r *schema.Resource) {
+	guard := func(operation string, fn func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			if c, ok := meta.(*clients.Clients); ok && c.ReadOnly {
+				return diag.Diagnostics{{
+					Severity: diag.Error,
+					Summary:  "The Formal provider is in read-only mode",
+					Detail:   fmt.Sprintf("Cannot %s %s because read_only is set to true in the provider configuration.", operation, typeName),
+				}}
+			}
+			return fn(ctx, d, meta)
+		}
+	}
+
+	r.CreateContext = guard("create", r.CreateContext)
+	r.UpdateContext = guard("update", r.UpdateContext)
+	r.DeleteContext = guard("delete", r.DeleteContext)
+}
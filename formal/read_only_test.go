@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func TestGuardReadOnly(t *testing.T) {
+	var called []string
+	record := func(operation string) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		return func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+			called = append(called, operation)
+			return nil
+		}
+	}
+	r := &schema.Resource{
+		Schema:        map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		CreateContext: record("create"),
+		ReadContext:   record("read"),
+		UpdateContext: record("update"),
+		DeleteContext: record("delete"),
+	}
+	guardReadOnly("formal_test", r)
+	d := r.TestResourceData()
+
+	readOnly := &clients.Clients{ReadOnly: true}
+	for _, fn := range []func(context.Context, *schema.ResourceData, any) diag.Diagnostics{r.CreateContext, r.UpdateContext, r.DeleteContext} {
+		diags := fn(t.Context(), d, readOnly)
+		require.True(t, diags.HasError())
+	}
+	require.False(t, r.ReadContext(t.Context(), d, readOnly).HasError())
+	require.Equal(t, []string{"read"}, called)
+
+	called = nil
+	for _, fn := range []func(context.Context, *schema.ResourceData, any) diag.Diagnostics{r.CreateContext, r.UpdateContext, r.DeleteContext} {
+		require.False(t, fn(t.Context(), d, &clients.Clients{}).HasError())
+	}
+	require.Equal(t, []string{"create", "update", "delete"}, called)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorAiProvider_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	connector := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector_ai_provider", "core.v1.ConnectorAiProvider"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorAiProviderConfig(connector, "us-east-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector_ai_provider.test", "core.v1.ConnectorAiProvider"),
+					resource.TestCheckResourceAttrPair("formal_connector_ai_provider.test", "connector_id", "formal_connector.test", "id"),
+					resource.TestCheckResourceAttr("formal_connector_ai_provider.test", "aws_bedrock.0.region", "us-east-1"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorAiProviderConfig(connector, "eu-west-1"),
+				Check:  resource.TestCheckResourceAttr("formal_connector_ai_provider.test", "aws_bedrock.0.region", "eu-west-1"),
+			},
+			{
+				ResourceName:      "formal_connector_ai_provider.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConnectorAiProviderConfig(connector, region string) string {
+	return testAccConnectorConfig(connector, false) + fmt.Sprintf(`
+resource "formal_connector_ai_provider" "test" {
+  connector_id = formal_connector.test.id
+
+  aws_bedrock {
+    region = %q
+  }
+}
+`, region)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorConfiguration_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	connector := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector_configuration", "core.v1.ConnectorConfiguration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorConfigurationConfig(connector, "info", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector_configuration.test", "core.v1.ConnectorConfiguration"),
+					resource.TestCheckResourceAttrPair("formal_connector_configuration.test", "connector_id", "formal_connector.test", "id"),
+					resource.TestCheckResourceAttr("formal_connector_configuration.test", "log_level", "info"),
+					resource.TestCheckResourceAttr("formal_connector_configuration.test", "resources_health_checks_frequency", "60"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorConfigurationConfig(connector, "debug", 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_connector_configuration.test", "log_level", "debug"),
+					resource.TestCheckResourceAttr("formal_connector_configuration.test", "resources_health_checks_frequency", "120"),
+				),
+			},
+			{
+				ResourceName:      "formal_connector_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConnectorConfigurationConfig(connector, logLevel string, frequency int) string {
+	return testAccConnectorConfig(connector, false) + fmt.Sprintf(`
+resource "formal_connector_configuration" "test" {
+  connector_id                      = formal_connector.test.id
+  log_level                         = %q
+  resources_health_checks_frequency = %d
+}
+`, logLevel, frequency)
+}
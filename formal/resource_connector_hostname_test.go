@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorHostname_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	connector := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector_hostname", "core.v1.ConnectorHostname"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorHostnameConfig(connector, "connector.example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector_hostname.test", "core.v1.ConnectorHostname"),
+					testAccStoreID("formal_connector_hostname.test", &id),
+					resource.TestCheckResourceAttrPair("formal_connector_hostname.test", "connector_id", "formal_connector.test", "id"),
+					resource.TestCheckResourceAttr("formal_connector_hostname.test", "hostname", "connector.example.com"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorHostnameConfig(connector, "connector-2.example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_connector_hostname.test", &id),
+					resource.TestCheckResourceAttr("formal_connector_hostname.test", "hostname", "connector-2.example.com"),
+				),
+			},
+			{
+				ResourceName:      "formal_connector_hostname.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// managed_tls is deprecated and isn't returned by the API.
+				ImportStateVerifyIgnore: []string{"managed_tls"},
+			},
+		},
+	})
+}
+
+func testAccConnectorHostnameConfig(connector, hostname string, terminationProtection bool) string {
+	return testAccConnectorConfig(connector, false) + fmt.Sprintf(`
+resource "formal_connector_hostname" "test" {
+  connector_id           = formal_connector.test.id
+  hostname               = %q
+  termination_protection = %t
+}
+`, hostname, terminationProtection)
+}
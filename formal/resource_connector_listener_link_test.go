@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorListenerLink_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector_listener_link", "core.v1.ConnectorListenerLink"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorListenerLinkConfig(name, "first", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector_listener_link.test", "core.v1.ConnectorListenerLink"),
+					testAccStoreID("formal_connector_listener_link.test", &id),
+					resource.TestCheckResourceAttrPair("formal_connector_listener_link.test", "connector_listener_id", "formal_connector_listener.test", "id"),
+					resource.TestCheckResourceAttrPair("formal_connector_listener_link.test", "connector_id", "formal_connector.first", "id"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorListenerLinkConfig(name, "second", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_connector_listener_link.test", &id),
+					resource.TestCheckResourceAttrPair("formal_connector_listener_link.test", "connector_id", "formal_connector.second", "id"),
+				),
+			},
+			{
+				ResourceName:      "formal_connector_listener_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "formal_connector_listener_link.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromKey("formal_connector_listener_link.test", "connector_id", "connector_listener_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConnectorListenerLinkConfig(name, connector string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_connector" "first" {
+  name = "%[1]s-first"
+}
+
+resource "formal_connector" "second" {
+  name = "%[1]s-second"
+}
+
+resource "formal_connector_listener" "test" {
+  name = %[1]q
+  port = 5432
+}
+
+resource "formal_connector_listener_link" "test" {
+  connector_listener_id  = formal_connector_listener.test.id
+  connector_id           = formal_connector.%[2]s.id
+  termination_protection = %[3]t
+}
+`, name, connector, terminationProtection)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorListenerRule_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector_listener_rule", "core.v1.ConnectorListenerRule"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorListenerRuleConfig(name, "technology", "postgres", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector_listener_rule.test", "core.v1.ConnectorListenerRule"),
+					testAccStoreID("formal_connector_listener_rule.test", &id),
+					resource.TestCheckResourceAttrPair("formal_connector_listener_rule.test", "connector_listener_id", "formal_connector_listener.test", "id"),
+					resource.TestCheckResourceAttr("formal_connector_listener_rule.test", "type", "technology"),
+					resource.TestCheckResourceAttr("formal_connector_listener_rule.test", "rule", "postgres"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorListenerRuleConfig(name, "any", "any", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_connector_listener_rule.test", &id),
+					resource.TestCheckResourceAttr("formal_connector_listener_rule.test", "type", "any"),
+				),
+			},
+			{
+				ResourceName:      "formal_connector_listener_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConnectorListenerRuleConfig(name, ruleType, rule string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_connector_listener" "test" {
+  name = %[1]q
+  port = 5432
+}
+
+resource "formal_connector_listener_rule" "test" {
+  connector_listener_id  = formal_connector_listener.test.id
+  type                   = %[2]q
+  rule                   = %[3]q
+  termination_protection = %[4]t
+}
+`, name, ruleType, rule, terminationProtection)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorListener_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector_listener", "core.v1.ConnectorListener"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorListenerConfig(name, 5432, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector_listener.test", "core.v1.ConnectorListener"),
+					testAccStoreID("formal_connector_listener.test", &id),
+					resource.TestCheckResourceAttr("formal_connector_listener.test", "name", name),
+					resource.TestCheckResourceAttr("formal_connector_listener.test", "port", "5432"),
+					resource.TestCheckResourceAttrPair("formal_connector_listener.test", "connector_id", "formal_connector.test", "id"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorListenerConfig(name, 5433, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_connector_listener.test", "port", "5433"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorListenerConfig(name+"-renamed", 5433, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_connector_listener.test", &id),
+					resource.TestCheckResourceAttr("formal_connector_listener.test", "name", name+"-renamed"),
+				),
+			},
+			{
+				ResourceName:      "formal_connector_listener.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccConnectorListener_healthCheckPort(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      server.ProviderConfig() + testAccConnectorListenerConfig(testAccName(), 8080, false),
+				ExpectError: regexp.MustCompile(`health check port \(8080\)`),
+			},
+		},
+	})
+}
+
+func testAccConnectorListenerConfig(name string, port int, terminationProtection bool) string {
+	return testAccConnectorConfig(name, false) + fmt.Sprintf(`
+resource "formal_connector_listener" "test" {
+  name                   = %q
+  port                   = %d
+  connector_id           = formal_connector.test.id
+  termination_protection = %t
+}
+`, name, port, terminationProtection)
+}
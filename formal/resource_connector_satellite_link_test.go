@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorSatelliteLink_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector_satellite_link", "core.v1.ConnectorSatelliteLink"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorSatelliteLinkConfig(name, "ai"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector_satellite_link.test", "core.v1.ConnectorSatelliteLink"),
+					testAccStoreID("formal_connector_satellite_link.test", &id),
+					resource.TestCheckResourceAttrPair("formal_connector_satellite_link.test", "connector_id", "formal_connector.test", "id"),
+					resource.TestCheckResourceAttrPair("formal_connector_satellite_link.test", "satellite_id", "formal_satellite.ai", "id"),
+					resource.TestCheckResourceAttr("formal_connector_satellite_link.test", "satellite_type", "ai"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorSatelliteLinkConfig(name, "data_classifier"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_connector_satellite_link.test", &id),
+					resource.TestCheckResourceAttrPair("formal_connector_satellite_link.test", "satellite_id", "formal_satellite.data_classifier", "id"),
+				),
+			},
+			{
+				ResourceName:      "formal_connector_satellite_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConnectorSatelliteLinkConfig(name, satelliteType string) string {
+	return testAccConnectorConfig(name, false) + fmt.Sprintf(`
+resource "formal_satellite" "ai" {
+  name           = "%[1]s-ai"
+  satellite_type = "ai"
+}
+
+resource "formal_satellite" "data_classifier" {
+  name           = "%[1]s-data-classifier"
+  satellite_type = "data_classifier"
+}
+
+resource "formal_connector_satellite_link" "test" {
+  connector_id   = formal_connector.test.id
+  satellite_id   = formal_satellite.%[2]s.id
+  satellite_type = %[2]q
+}
+`, name, satelliteType)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnector_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector", "core.v1.Connector"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector.test", "core.v1.Connector"),
+					resource.TestCheckResourceAttr("formal_connector.test", "name", name),
+					resource.TestCheckResourceAttrSet("formal_connector.test", "api_key"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "formal_space" "test" {
+  name = %[1]q
+}
+
+resource "formal_connector" "test" {
+  name     = %[1]q
+  space_id = formal_space.test.id
+}
+`, name),
+				Check: resource.TestCheckResourceAttrPair("formal_connector.test", "space_id", "formal_space.test", "id"),
+			},
+			{
+				ResourceName:      "formal_connector.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConnectorConfig(name string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_connector" "test" {
+  name                   = %q
+  termination_protection = %t
+}
+`, name, terminationProtection)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccConnectorTokenEncryptionKey_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	connector := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_connector_token_encryption_key", "core.v1.ConnectorTokenEncryptionKey"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccConnectorTokenEncryptionKeyConfig(connector, "arn:aws:kms:us-east-1:123456789012:key/first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_connector_token_encryption_key.test", "core.v1.ConnectorTokenEncryptionKey"),
+					testAccStoreID("formal_connector_token_encryption_key.test", &id),
+					resource.TestCheckResourceAttrPair("formal_connector_token_encryption_key.test", "connector_id", "formal_connector.test", "id"),
+					resource.TestCheckResourceAttr("formal_connector_token_encryption_key.test", "key_provider", "aws-kms"),
+					resource.TestCheckResourceAttr("formal_connector_token_encryption_key.test", "key_id", "arn:aws:kms:us-east-1:123456789012:key/first"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccConnectorTokenEncryptionKeyConfig(connector, "arn:aws:kms:us-east-1:123456789012:key/second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_connector_token_encryption_key.test", &id),
+					resource.TestCheckResourceAttr("formal_connector_token_encryption_key.test", "key_id", "arn:aws:kms:us-east-1:123456789012:key/second"),
+				),
+			},
+			{
+				ResourceName:      "formal_connector_token_encryption_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConnectorTokenEncryptionKeyConfig(connector, keyID string) string {
+	return testAccConnectorConfig(connector, false) + fmt.Sprintf(`
+resource "formal_connector_token_encryption_key" "test" {
+  connector_id = formal_connector.test.id
+  key_provider = "aws-kms"
+  key_id       = %q
+}
+`, keyID)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccDataDiscovery_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_data_discovery", "core.v1.DataDiscoveryConfiguration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccDataDiscoveryConfig(name, "24h", "delete"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_data_discovery.test", "core.v1.DataDiscoveryConfiguration"),
+					testAccStoreID("formal_data_discovery.test", &id),
+					resource.TestCheckResourceAttrPair("formal_data_discovery.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttrPair("formal_data_discovery.test", "native_user_id", "formal_native_user.test", "id"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccDataDiscoveryConfig(name, "0 4,16 * * *", "mark_for_deletion"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("formal_data_discovery.test", "id", &id),
+					resource.TestCheckResourceAttr("formal_data_discovery.test", "schedule", "0 4,16 * * *"),
+					resource.TestCheckResourceAttr("formal_data_discovery.test", "deletion_policy", "mark_for_deletion"),
+				),
+			},
+			{
+				ResourceName:      "formal_data_discovery.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Read only refreshes the ID of the configuration.
+				ImportStateVerifyIgnore: []string{"resource_id", "native_user_id", "schedule", "deletion_policy", "path"},
+			},
+		},
+	})
+}
+
+func testAccDataDiscoveryConfig(name, schedule, deletionPolicy string) string {
+	return testAccNativeUserConfig(name, "postgres", false, false) + fmt.Sprintf(`
+resource "formal_data_discovery" "test" {
+  resource_id     = formal_resource.test.id
+  native_user_id  = formal_native_user.test.id
+  schedule        = %q
+  deletion_policy = %q
+}
+`, schedule, deletionPolicy)
+}
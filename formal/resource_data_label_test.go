@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccDataLabel_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_data_label", "core.v1.DataLabel"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccDataLabelConfig(name, "regex", `^[0-9]{3}-[0-9]{2}-[0-9]{4}$`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_data_label.test", "core.v1.DataLabel"),
+					resource.TestCheckResourceAttr("formal_data_label.test", "name", name),
+					resource.TestCheckResourceAttr("formal_data_label.test", "classifier_type", "regex"),
+					resource.TestCheckResourceAttr("formal_data_label.test", "classifier_data", `^[0-9]{3}-[0-9]{2}-[0-9]{4}$`),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccDataLabelConfig(name+"-renamed", "prompt", "Social security numbers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_data_label.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_data_label.test", "classifier_type", "prompt"),
+					resource.TestCheckResourceAttr("formal_data_label.test", "classifier_data", "Social security numbers"),
+				),
+			},
+			{
+				ResourceName:      "formal_data_label.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataLabelConfig(name, classifierType, classifierData string) string {
+	return fmt.Sprintf(`
+resource "formal_data_label" "test" {
+  name            = %q
+  classifier_type = %q
+  classifier_data = %q
+}
+`, name, classifierType, classifierData)
+}
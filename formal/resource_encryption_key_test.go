@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccEncryptionKey_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_encryption_key", "core.v1.EncryptionKey"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccEncryptionKeyConfig("aws-kms", "arn:aws:kms:us-east-1:123456789012:key/first", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_encryption_key.test", "core.v1.EncryptionKey"),
+					testAccStoreID("formal_encryption_key.test", &id),
+					resource.TestCheckResourceAttr("formal_encryption_key.test", "key_provider", "aws-kms"),
+					resource.TestCheckResourceAttr("formal_encryption_key.test", "algorithm", "rsaes_oaep_sha256"),
+					resource.TestCheckResourceAttrSet("formal_encryption_key.test", "created_at"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccEncryptionKeyConfig("aws-kms", "arn:aws:kms:us-east-1:123456789012:key/second", "https://decryptor.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("formal_encryption_key.test", "id", &id),
+					resource.TestCheckResourceAttr("formal_encryption_key.test", "key_id", "arn:aws:kms:us-east-1:123456789012:key/second"),
+					resource.TestCheckResourceAttr("formal_encryption_key.test", "decryptor_uri", "https://decryptor.example.com"),
+				),
+			},
+			{
+				ResourceName:      "formal_encryption_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEncryptionKeyConfig(keyProvider, keyID, decryptorURI string) string {
+	return fmt.Sprintf(`
+resource "formal_encryption_key" "test" {
+  key_provider  = %q
+  key_id        = %q
+  decryptor_uri = %q
+}
+`, keyProvider, keyID, decryptorURI)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccForm_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_form", "core.v1.Form"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccFormConfig(name, "Reason"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_form.test", "core.v1.Form"),
+					resource.TestCheckResourceAttr("formal_form.test", "name", name),
+					resource.TestCheckResourceAttr("formal_form.test", "field.#", "2"),
+					resource.TestCheckResourceAttr("formal_form.test", "field.0.name", "Reason"),
+					resource.TestCheckResourceAttr("formal_form.test", "field.1.type", "select"),
+					resource.TestCheckResourceAttr("formal_form.test", "field.1.config.0.option.#", "2"),
+					resource.TestCheckResourceAttr("formal_form.test", "field.1.config.0.option.1.value", "write"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccFormConfig(name+"-renamed", "Justification"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_form.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_form.test", "field.0.name", "Justification"),
+				),
+			},
+			{
+				ResourceName:      "formal_form.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFormConfig(name, reasonName string) string {
+	return fmt.Sprintf(`
+resource "formal_form" "test" {
+  name        = %q
+  description = "Created by acceptance tests"
+
+  field {
+    id   = "reason"
+    name = %q
+    type = "string"
+  }
+
+  field {
+    id   = "access"
+    name = "Access"
+    type = "select"
+
+    config {
+      option {
+        label = "Read"
+        value = "read"
+      }
+      option {
+        label = "Write"
+        value = "write"
+      }
+    }
+  }
+}
+`, name, reasonName)
+}
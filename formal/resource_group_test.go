@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccGroup_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_group", "core.v1.Group"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccGroupConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_group.test", "core.v1.Group"),
+					resource.TestCheckResourceAttr("formal_group.test", "name", name),
+					resource.TestCheckResourceAttr("formal_group.test", "description", "Created by acceptance tests"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccGroupConfig(name+"-renamed", false),
+				Check:  resource.TestCheckResourceAttr("formal_group.test", "name", name+"-renamed"),
+			},
+			{
+				ResourceName:      "formal_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGroupConfig(name string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_group" "test" {
+  name                   = %q
+  description            = "Created by acceptance tests"
+  termination_protection = %t
+}
+`, name, terminationProtection)
+}
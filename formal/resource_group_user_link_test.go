@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+func TestAccGroupUserLink_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	group := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_group_user_link", "core.v1.UserGroupLink"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccGroupUserLinkConfig(group, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_group_user_link.test", "core.v1.UserGroupLink"),
+					testAccStoreID("formal_group_user_link.test", &id),
+					resource.TestCheckResourceAttrPair("formal_group_user_link.test", "group_id", "formal_group.test", "id"),
+					resource.TestCheckResourceAttrPair("formal_group_user_link.test", "user_id", "formal_user.first", "id"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccGroupUserLinkConfig(group, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_group_user_link.test", &id),
+					resource.TestCheckResourceAttrPair("formal_group_user_link.test", "user_id", "formal_user.second", "id"),
+				),
+			},
+			{
+				ResourceName:      "formal_group_user_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "formal_group_user_link.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromKey("formal_group_user_link.test", "group_id", "user_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccGroupUserLink_manyLinks reads a link listed after the first page of
+// links of the API.
+func TestAccGroupUserLink_manyLinks(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	for range paging.PageSize {
+		testAccPut(t, server, "core.v1.UserGroupLink", `{"group": {"id": "group_other"}, "user": {"id": "user_other"}}`)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_group_user_link", "core.v1.UserGroupLink"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccGroupUserLinkConfig(testAccName(), "first"),
+				Check:  testAccCheckExists(server, "formal_group_user_link.test", "core.v1.UserGroupLink"),
+			},
+			{
+				ResourceName:      "formal_group_user_link.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromKey("formal_group_user_link.test", "group_id", "user_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGroupUserLinkConfig(group, user string) string {
+	return fmt.Sprintf(`
+resource "formal_group" "test" {
+  name        = %q
+  description = "Created by acceptance tests"
+}
+
+resource "formal_user" "first" {
+  type = "machine"
+  name = "%[1]s-first"
+}
+
+resource "formal_user" "second" {
+  type = "machine"
+  name = "%[1]s-second"
+}
+
+resource "formal_group_user_link" "test" {
+  group_id = formal_group.test.id
+  user_id  = formal_user.%[2]s.id
+}
+`, group, user)
+}
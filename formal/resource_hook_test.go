@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccHook_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_hook", "core.v1.Hook"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccHookConfig("draft", 5000, "AWS_REGION"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_hook.test", "core.v1.Hook"),
+					resource.TestCheckResourceAttr("formal_hook.test", "name", "tf_acc_hook"),
+					resource.TestCheckResourceAttr("formal_hook.test", "status", "draft"),
+					resource.TestCheckResourceAttr("formal_hook.test", "timeout_ms", "5000"),
+					resource.TestCheckTypeSetElemAttr("formal_hook.test", "allowlisted_environment_variables.*", "AWS_REGION"),
+					resource.TestCheckResourceAttrSet("formal_hook.test", "created_at"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccHookConfig("active", 10000, "AWS_DEFAULT_REGION"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_hook.test", "status", "active"),
+					resource.TestCheckResourceAttr("formal_hook.test", "timeout_ms", "10000"),
+					resource.TestCheckTypeSetElemAttr("formal_hook.test", "allowlisted_environment_variables.*", "AWS_DEFAULT_REGION"),
+				),
+			},
+			{
+				ResourceName:      "formal_hook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccHookConfig doesn't use testAccName because hook names must be valid
+// identifiers.
+func testAccHookConfig(status string, timeoutMs int, environmentVariable string) string {
+	return fmt.Sprintf(`
+resource "formal_hook" "test" {
+  name                              = "tf_acc_hook"
+  description                       = "Created by acceptance tests"
+  status                            = %q
+  timeout_ms                        = %d
+  allowlisted_environment_variables = [%q]
+  code                              = <<-JS
+    export default function hook(input, env) {
+      return { score: 1 };
+    }
+  JS
+}
+`, status, timeoutMs, environmentVariable)
+}
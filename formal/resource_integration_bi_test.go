@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccIntegrationBI_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_integration_bi", "core.v1.BIIntegration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccIntegrationBIConfig(name, "metabase.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_integration_bi.test", "core.v1.BIIntegration"),
+					testAccStoreID("formal_integration_bi.test", &id),
+					resource.TestCheckResourceAttr("formal_integration_bi.test", "name", name),
+					resource.TestCheckResourceAttr("formal_integration_bi.test", "sync", "true"),
+					resource.TestCheckResourceAttr("formal_integration_bi.test", "metabase.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("formal_integration_bi.test", "metabase.*", map[string]string{
+						"hostname": "metabase.example.com",
+						"username": "formal",
+					}),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccIntegrationBIConfig(name, "metabase-2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_integration_bi.test", &id),
+					resource.TestCheckTypeSetElemNestedAttrs("formal_integration_bi.test", "metabase.*", map[string]string{
+						"hostname": "metabase-2.example.com",
+					}),
+				),
+			},
+			{
+				ResourceName:      "formal_integration_bi.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIntegrationBIConfig(name, hostname string) string {
+	return fmt.Sprintf(`
+resource "formal_integration_bi" "test" {
+  name = %q
+  sync = true
+
+  metabase {
+    hostname = %q
+    username = "formal"
+    password = "secret"
+  }
+}
+`, name, hostname)
+}
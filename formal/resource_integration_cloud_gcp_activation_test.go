@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccIntegrationCloudGCPActivation_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_integration_cloud", "core.v1.CloudIntegration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccIntegrationCloudGCPActivationConfig(name, "formal@tf-acc-project.iam.gserviceaccount.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("formal_integration_cloud_gcp_activation.test", "id", "formal_integration_cloud.test", "id"),
+					resource.TestCheckResourceAttr("formal_integration_cloud_gcp_activation.test", "service_account_email", "formal@tf-acc-project.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccIntegrationCloudGCPActivationConfig(name, "formal-2@tf-acc-project.iam.gserviceaccount.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_integration_cloud_gcp_activation.test", "service_account_email", "formal-2@tf-acc-project.iam.gserviceaccount.com"),
+					resource.TestCheckResourceAttr("formal_integration_cloud.test", "gcp_service_account_email", "formal-2@tf-acc-project.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				ResourceName:      "formal_integration_cloud_gcp_activation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIntegrationCloudGCPActivationConfig(name, serviceAccountEmail string) string {
+	return testAccIntegrationCloudGCPConfig(name, false) + fmt.Sprintf(`
+resource "formal_integration_cloud_gcp_activation" "test" {
+  integration_id                  = formal_integration_cloud.test.id
+  service_account_email           = %q
+  workload_identity_pool_provider = "projects/123/locations/global/workloadIdentityPools/formal/providers/formal"
+}
+`, serviceAccountEmail)
+}
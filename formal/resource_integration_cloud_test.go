@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccIntegrationCloud_gcp(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_integration_cloud", "core.v1.CloudIntegration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccIntegrationCloudGCPConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_integration_cloud.test", "core.v1.CloudIntegration"),
+					testAccStoreID("formal_integration_cloud.test", &id),
+					resource.TestCheckResourceAttr("formal_integration_cloud.test", "name", name),
+					resource.TestCheckResourceAttr("formal_integration_cloud.test", "type", "gcp"),
+					resource.TestCheckResourceAttr("formal_integration_cloud.test", "gcp.0.project_id", "tf-acc-project"),
+					resource.TestCheckResourceAttr("formal_integration_cloud.test", "gcp_project_id", "tf-acc-project"),
+					resource.TestCheckResourceAttr("formal_integration_cloud.test", "gcp_allow_gcs_access", "false"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccIntegrationCloudGCPConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("formal_integration_cloud.test", "id", &id),
+					resource.TestCheckResourceAttr("formal_integration_cloud.test", "gcp.0.allow_gcs_access", "true"),
+					resource.TestCheckResourceAttr("formal_integration_cloud.test", "gcp_allow_gcs_access", "true"),
+				),
+			},
+			{
+				ResourceName:      "formal_integration_cloud.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIntegrationCloudGCPConfig(name string, allowGCSAccess bool) string {
+	return fmt.Sprintf(`
+resource "formal_integration_cloud" "test" {
+  name = %q
+
+  gcp {
+    project_id       = "tf-acc-project"
+    allow_gcs_access = %t
+  }
+}
+`, name, allowGCSAccess)
+}
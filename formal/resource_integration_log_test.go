@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccIntegrationLog_gcs(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_integration_log", "core.v1.IntegrationLog"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccIntegrationLogGCSConfig(name, "logs", "gzip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_integration_log.test", "core.v1.IntegrationLog"),
+					testAccStoreID("formal_integration_log.test", &id),
+					resource.TestCheckResourceAttr("formal_integration_log.test", "name", name),
+					resource.TestCheckTypeSetElemNestedAttrs("formal_integration_log.test", "gcs.*", map[string]string{
+						"gcs_bucket_name":   "tf-acc-logs",
+						"gcs_bucket_prefix": "logs",
+						"compression":       "gzip",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("formal_integration_log.test", "gcs.*.cloud_integration_id", "formal_integration_cloud.test", "id"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccIntegrationLogGCSConfig(name, "audit", "zstd"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_integration_log.test", &id),
+					resource.TestCheckTypeSetElemNestedAttrs("formal_integration_log.test", "gcs.*", map[string]string{
+						"gcs_bucket_prefix": "audit",
+						"compression":       "zstd",
+					}),
+				),
+			},
+			{
+				ResourceName:      "formal_integration_log.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIntegrationLogGCSConfig(name, prefix, compression string) string {
+	return testAccIntegrationCloudGCPConfig(name, true) + fmt.Sprintf(`
+resource "formal_integration_log" "test" {
+  name = %q
+
+  gcs {
+    cloud_integration_id = formal_integration_cloud.test.id
+    gcs_bucket_name      = "tf-acc-logs"
+    gcs_bucket_prefix    = %q
+    compression          = %q
+  }
+}
+`, name, prefix, compression)
+}
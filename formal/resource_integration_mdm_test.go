@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+// The MDM integrations can't be updated in place, every change replaces them.
+func TestAccIntegrationMDM_kandji(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_integration_mdm", "core.v1.IntegrationMDM"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccIntegrationMDMKandjiConfig(name, "https://tf-acc.api.kandji.io"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_integration_mdm.test", "core.v1.IntegrationMDM"),
+					testAccStoreID("formal_integration_mdm.test", &id),
+					resource.TestCheckResourceAttr("formal_integration_mdm.test", "name", name),
+					resource.TestCheckResourceAttr("formal_integration_mdm.test", "kandji.0.api_url", "https://tf-acc.api.kandji.io"),
+					resource.TestCheckNoResourceAttr("formal_integration_mdm.test", "kandji.0.api_key"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccIntegrationMDMKandjiConfig(name, "https://tf-acc-2.api.kandji.io"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_integration_mdm.test", &id),
+					resource.TestCheckResourceAttr("formal_integration_mdm.test", "kandji.0.api_url", "https://tf-acc-2.api.kandji.io"),
+				),
+			},
+			{
+				ResourceName:      "formal_integration_mdm.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIntegrationMDMKandjiConfig(name, apiURL string) string {
+	return fmt.Sprintf(`
+resource "formal_integration_mdm" "test" {
+  name = %q
+
+  kandji {
+    api_key = "secret"
+    api_url = %q
+  }
+}
+`, name, apiURL)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccIntegrationOIDC_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_integration_oidc", "core.v1.IntegrationOIDC"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccIntegrationOIDCConfig(name, "https://token.actions.githubusercontent.com", "draft"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_integration_oidc.test", "core.v1.IntegrationOIDC"),
+					resource.TestCheckResourceAttr("formal_integration_oidc.test", "name", name),
+					resource.TestCheckResourceAttr("formal_integration_oidc.test", "status", "draft"),
+					resource.TestCheckResourceAttr("formal_integration_oidc.test", "claim_condition", "true"),
+					resource.TestCheckResourceAttrPair("formal_integration_oidc.test", "machine_user_id", "formal_user.test", "id"),
+					resource.TestCheckResourceAttrSet("formal_integration_oidc.test", "audience"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccIntegrationOIDCConfig(name+"-renamed", "https://gitlab.example.com", "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_integration_oidc.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_integration_oidc.test", "issuer", "https://gitlab.example.com"),
+					resource.TestCheckResourceAttr("formal_integration_oidc.test", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "formal_integration_oidc.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIntegrationOIDCConfig(name, issuer, status string) string {
+	return testAccUserMachineConfig(name) + fmt.Sprintf(`
+resource "formal_integration_oidc" "test" {
+  name            = %q
+  issuer          = %q
+  machine_user_id = formal_user.test.id
+  status          = %q
+}
+`, name, issuer, status)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccInventoryObjectDataLabelLink_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	testAccHandleInventory(server)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInventoryObjectDataLabelLinkDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccInventoryObjectDataLabelLinkConfig(name, "email", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("formal_inventory_object_data_label_link.test", "id", "formal_inventory_object.column", "id"),
+					resource.TestCheckResourceAttrPair("formal_inventory_object_data_label_link.test", "data_label", "formal_data_label.email", "name"),
+					resource.TestCheckResourceAttr("formal_inventory_object_data_label_link.test", "locked", "false"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccInventoryObjectDataLabelLinkConfig(name, "phone", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("formal_inventory_object_data_label_link.test", "data_label", "formal_data_label.phone", "name"),
+					resource.TestCheckResourceAttr("formal_inventory_object_data_label_link.test", "locked", "true"),
+				),
+			},
+			{
+				ResourceName:      "formal_inventory_object_data_label_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "formal_inventory_object_data_label_link.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromKey("formal_inventory_object_data_label_link.test", "resource_id", "path"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckInventoryObjectDataLabelLinkDestroy checks that the columns of
+// the destroyed links no longer have a data label.
+func testAccCheckInventoryObjectDataLabelLinkDestroy(server *fakeapi.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "formal_inventory_object_data_label_link" {
+				continue
+			}
+			obj := testAccObject(server, "core.v1.InventoryObject", rs.Primary.ID)
+			if obj == nil {
+				continue
+			}
+			column := obj.Get(obj.Descriptor().Fields().ByName("column")).Message()
+			if label := testAccStringField(column, "data_label"); label != "" {
+				return fmt.Errorf("column %s is still labeled %s", rs.Primary.ID, label)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccInventoryObjectDataLabelLinkConfig labels a column with the data
+// label named email or phone.
+func testAccInventoryObjectDataLabelLinkConfig(name, dataLabel string, locked bool) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_inventory_object" "column" {
+  resource_id = formal_resource.test.id
+  type        = "column"
+  path        = "main.public.users.email"
+  name        = "email"
+  data_type   = "text"
+}
+
+resource "formal_data_label" "email" {
+  name            = "%[1]s-email"
+  classifier_type = "prompt"
+  classifier_data = "Email addresses"
+}
+
+resource "formal_data_label" "phone" {
+  name            = "%[1]s-phone"
+  classifier_type = "prompt"
+  classifier_data = "Phone numbers"
+}
+
+resource "formal_inventory_object_data_label_link" "test" {
+  resource_id = formal_resource.test.id
+  path        = formal_inventory_object.column.path
+  data_label  = formal_data_label.%[2]s.name
+  locked      = %[3]t
+}
+`, name, dataLabel, locked)
+}
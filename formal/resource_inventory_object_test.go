@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccInventoryObject_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	testAccHandleInventory(server)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_inventory_object", "core.v1.InventoryObject"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccInventoryObjectConfig(name, "users"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_inventory_object.test", "core.v1.InventoryObject"),
+					testAccStoreID("formal_inventory_object.test", &id),
+					resource.TestCheckResourceAttrPair("formal_inventory_object.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttr("formal_inventory_object.test", "type", "table"),
+					resource.TestCheckResourceAttr("formal_inventory_object.test", "path", "main.public.users"),
+					resource.TestCheckResourceAttr("formal_inventory_object.test", "name", "users"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccInventoryObjectConfig(name, "accounts"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_inventory_object.test", &id),
+					resource.TestCheckResourceAttr("formal_inventory_object.test", "path", "main.public.accounts"),
+				),
+			},
+			{
+				ResourceName:      "formal_inventory_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccHandleInventory replaces the inventory methods that the fake API
+// can't serve by convention: inventory objects store the resource ID of the
+// request in their typed object, and columns are labeled by resource ID and
+// path rather than by ID.
+func testAccHandleInventory(server *fakeapi.Server) {
+	server.Handle("/core.v1.InventoryService/CreateInventoryObject", func(ctx context.Context, req, res protoreflect.Message) error {
+		objectType := strings.ReplaceAll(testAccStringField(req, "object_type"), "-", "_")
+		typed := map[string]any{"resource_id": testAccStringField(req, "datastore_id")}
+		req.Get(req.Descriptor().Fields().ByName(protoreflect.Name(objectType))).Message().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			typed[string(field.Name())] = value.Interface()
+			return true
+		})
+		object, err := json.Marshal(map[string]any{objectType: typed})
+		if err != nil {
+			return err
+		}
+		id, err := server.Put("core.v1.InventoryObject", string(object))
+		if err != nil {
+			return err
+		}
+		res.Set(res.Descriptor().Fields().ByName("id"), protoreflect.ValueOfString(id))
+		return nil
+	})
+
+	server.Handle("/core.v1.InventoryService/UpdateColumn", func(ctx context.Context, req, res protoreflect.Message) error {
+		for _, obj := range server.Objects("core.v1.InventoryObject") {
+			columnField := obj.Descriptor().Fields().ByName("column")
+			if !obj.Has(columnField) {
+				continue
+			}
+			column := obj.Mutable(columnField).Message()
+			if testAccStringField(column, "resource_id") != testAccStringField(req, "datastore_id") || testAccStringField(column, "path") != testAccStringField(req, "path") {
+				continue
+			}
+			column.Set(column.Descriptor().Fields().ByName("data_label"), req.Get(req.Descriptor().Fields().ByName("data_label")))
+			column.Set(column.Descriptor().Fields().ByName("data_label_locked_for_sidecar"), req.Get(req.Descriptor().Fields().ByName("lock_status_validated")))
+
+			id := testAccStringField(obj, "id")
+			object, err := protojson.Marshal(obj.Interface())
+			if err != nil {
+				return err
+			}
+			server.Delete("core.v1.InventoryObject", id)
+			if _, err := server.Put("core.v1.InventoryObject", string(object)); err != nil {
+				return err
+			}
+			res.Set(res.Descriptor().Fields().ByName("id"), protoreflect.ValueOfString(id))
+			return nil
+		}
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("column %s not found", testAccStringField(req, "path")))
+	})
+}
+
+func testAccStringField(m protoreflect.Message, name protoreflect.Name) string {
+	return m.Get(m.Descriptor().Fields().ByName(name)).String()
+}
+
+func testAccInventoryObjectConfig(name, table string) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_inventory_object" "test" {
+  resource_id = formal_resource.test.id
+  type        = "table"
+  path        = "main.public.%[1]s"
+  name        = %[1]q
+}
+`, table)
+}
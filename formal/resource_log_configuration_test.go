@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccLogConfiguration_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_log_configuration", "core.v1.LogConfiguration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccLogConfigurationConfig(name, false, 1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_log_configuration.test", "core.v1.LogConfiguration"),
+					resource.TestCheckResourceAttr("formal_log_configuration.test", "name", name),
+					resource.TestCheckTypeSetElemAttr("formal_log_configuration.test", "scope.*.type", "resource"),
+					resource.TestCheckTypeSetElemAttrPair("formal_log_configuration.test", "scope.*.resource_id", "formal_resource.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("formal_log_configuration.test", "request.*", map[string]string{
+						"encrypt":          "false",
+						"max_payload_size": "1024",
+					}),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccLogConfigurationConfig(name+"-renamed", true, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_log_configuration.test", "name", name+"-renamed"),
+					resource.TestCheckTypeSetElemNestedAttrs("formal_log_configuration.test", "request.*", map[string]string{
+						"encrypt":          "true",
+						"max_payload_size": "2048",
+					}),
+				),
+			},
+			{
+				ResourceName:      "formal_log_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLogConfigurationConfig(name string, encryptRequests bool, maxPayloadSize int) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_log_configuration" "test" {
+  name = %q
+
+  scope {
+    type        = "resource"
+    resource_id = formal_resource.test.id
+  }
+
+  request {
+    encrypt          = %t
+    max_payload_size = %d
+  }
+
+  response {
+    encrypt          = false
+    max_payload_size = 1024
+  }
+}
+`, name, encryptRequests, maxPayloadSize)
+}
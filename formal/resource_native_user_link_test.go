@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccNativeUserLink_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	testAccHandleNativeUserLink(server)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_native_user_link", "core.v1.NativeUserLink"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccNativeUserLinkConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_native_user_link.test", "core.v1.NativeUserLink"),
+					resource.TestCheckResourceAttrPair("formal_native_user_link.test", "native_user_id", "formal_native_user.test", "id"),
+					resource.TestCheckResourceAttrPair("formal_native_user_link.test", "formal_identity_id", "formal_user.test", "id"),
+					resource.TestCheckResourceAttr("formal_native_user_link.test", "formal_identity_type", "user"),
+				),
+			},
+			{
+				ResourceName:      "formal_native_user_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "formal_native_user_link.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromKey("formal_native_user_link.test", "resource_id", "native_user_id", "formal_identity_type", "formal_identity_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccHandleNativeUserLink stores the created links with their native user
+// and the user or group they link, which the fake API doesn't resolve from
+// the IDs of the request.
+func testAccHandleNativeUserLink(server *fakeapi.Server) {
+	server.Handle("/core.v1.ResourceService/CreateNativeUserIdentityLink", func(ctx context.Context, req, res protoreflect.Message) error {
+		nativeUserID := testAccStringField(req, "native_user_id")
+		nativeUser := testAccObject(server, "core.v1.NativeUser", nativeUserID)
+		if nativeUser == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("native user %s not found", nativeUserID))
+		}
+		object, err := json.Marshal(map[string]any{
+			"nativeUser": map[string]any{
+				"id":         nativeUserID,
+				"resourceId": testAccStringField(nativeUser, "resource_id"),
+			},
+			testAccStringField(req, "identity_type"): map[string]any{"id": testAccStringField(req, "identity_id")},
+			"terminationProtection":                  req.Get(req.Descriptor().Fields().ByName("termination_protection")).Bool(),
+		})
+		if err != nil {
+			return err
+		}
+		id, err := server.Put("core.v1.NativeUserLink", string(object))
+		if err != nil {
+			return err
+		}
+		link := res.Mutable(res.Descriptor().Fields().ByName("link")).Message()
+		link.Set(link.Descriptor().Fields().ByName("id"), protoreflect.ValueOfString(id))
+		return nil
+	})
+}
+
+func testAccNativeUserLinkConfig(name string, terminationProtection bool) string {
+	return testAccNativeUserConfig(name, "postgres", false, false) + testAccUserMachineConfig(name) + fmt.Sprintf(`
+resource "formal_native_user_link" "test" {
+  native_user_id         = formal_native_user.test.id
+  formal_identity_id     = formal_user.test.id
+  formal_identity_type   = "user"
+  termination_protection = %t
+}
+`, terminationProtection)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccNativeUser_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_native_user", "core.v1.NativeUser"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccNativeUserConfig(name, "postgres", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_native_user.test", "core.v1.NativeUser"),
+					testAccStoreID("formal_native_user.test", &id),
+					resource.TestCheckResourceAttrPair("formal_native_user.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttr("formal_native_user.test", "native_user_id", "postgres"),
+					resource.TestCheckResourceAttr("formal_native_user.test", "use_as_default", "false"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccNativeUserConfig(name, "postgres", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("formal_native_user.test", "id", &id),
+					resource.TestCheckResourceAttr("formal_native_user.test", "use_as_default", "true"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccNativeUserConfig(name, "admin", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_native_user.test", &id),
+					resource.TestCheckResourceAttr("formal_native_user.test", "native_user_id", "admin"),
+				),
+			},
+			{
+				ResourceName:      "formal_native_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API never returns the secret.
+				ImportStateVerifyIgnore: []string{"native_user_secret"},
+			},
+		},
+	})
+}
+
+func testAccNativeUserConfig(name, username string, useAsDefault, terminationProtection bool) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_native_user" "test" {
+  resource_id            = formal_resource.test.id
+  native_user_id         = %q
+  native_user_secret     = "secret"
+  use_as_default         = %t
+  termination_protection = %t
+}
+`, username, useAsDefault, terminationProtection)
+}
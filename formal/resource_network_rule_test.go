@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccNetworkRule_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_network_rule", "core.v1.DesktopRoutingRule"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccNetworkRuleConfig(name, `destination.host == "db.example.com"`, "draft"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_network_rule.test", "core.v1.DesktopRoutingRule"),
+					resource.TestCheckResourceAttr("formal_network_rule.test", "name", name),
+					resource.TestCheckResourceAttr("formal_network_rule.test", "cel_expression", `destination.host == "db.example.com"`),
+					resource.TestCheckResourceAttr("formal_network_rule.test", "status", "draft"),
+					resource.TestCheckResourceAttrSet("formal_network_rule.test", "created_at"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccNetworkRuleConfig(name+"-renamed", `destination.port == 5432`, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_network_rule.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_network_rule.test", "cel_expression", `destination.port == 5432`),
+					resource.TestCheckResourceAttr("formal_network_rule.test", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "formal_network_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetworkRuleConfig(name, celExpression, status string) string {
+	return fmt.Sprintf(`
+resource "formal_network_rule" "test" {
+  name           = %q
+  description    = "Created by acceptance tests"
+  cel_expression = %q
+  status         = %q
+}
+`, name, celExpression, status)
+}
+
+func testAccNetworkRuleTerminationProtectionConfig(name string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_network_rule" "test" {
+  name                   = %q
+  cel_expression         = "destination.port == 5432"
+  termination_protection = %t
+}
+`, name, terminationProtection)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccPermission_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_permission", "core.v1.Permission"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccPermissionConfig(name, "draft", "block", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_permission.test", "core.v1.Permission"),
+					resource.TestCheckResourceAttr("formal_permission.test", "name", name),
+					resource.TestCheckResourceAttr("formal_permission.test", "status", "draft"),
+					resource.TestCheckResourceAttr("formal_permission.test", "code", testAccPermissionCode("block")),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccPermissionConfig(name+"-renamed", "active", "allow", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_permission.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_permission.test", "status", "active"),
+					resource.TestCheckResourceAttr("formal_permission.test", "code", testAccPermissionCode("allow")),
+				),
+			},
+			{
+				ResourceName:      "formal_permission.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPermissionConfig(name, status, action string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_permission" "test" {
+  name                   = %q
+  description            = "Created by acceptance tests"
+  status                 = %q
+  code                   = %q
+  termination_protection = %t
+}
+`, name, status, testAccPermissionCode(action), terminationProtection)
+}
+
+func testAccPermissionCode(action string) string {
+	return fmt.Sprintf("package formal.app\n\nimport future.keywords.if\n\nallow := %q == \"allow\"\n", action)
+}
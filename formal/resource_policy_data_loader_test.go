@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccPolicyDataLoader_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_policy_data_loader", "core.v1.PolicyDataLoader"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccPolicyDataLoaderConfig(name, "draft", "*/10 * * * * *", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_policy_data_loader.test", "core.v1.PolicyDataLoader"),
+					resource.TestCheckResourceAttr("formal_policy_data_loader.test", "name", name),
+					resource.TestCheckResourceAttr("formal_policy_data_loader.test", "key", "tf_acc_key"),
+					resource.TestCheckResourceAttr("formal_policy_data_loader.test", "worker_runtime", "python3.11"),
+					resource.TestCheckResourceAttr("formal_policy_data_loader.test", "worker_schedule", "*/10 * * * * *"),
+					resource.TestCheckResourceAttr("formal_policy_data_loader.test", "status", "draft"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccPolicyDataLoaderConfig(name+"-renamed", "active", "*/30 * * * * *", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_policy_data_loader.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_policy_data_loader.test", "worker_schedule", "*/30 * * * * *"),
+					resource.TestCheckResourceAttr("formal_policy_data_loader.test", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "formal_policy_data_loader.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPolicyDataLoaderConfig(name, status, schedule string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_policy_data_loader" "test" {
+  name                   = %q
+  description            = "Created by acceptance tests"
+  key                    = "tf_acc_key"
+  worker_runtime         = "python3.11"
+  worker_code            = "print('{}')"
+  worker_schedule        = %q
+  status                 = %q
+  termination_protection = %t
+}
+`, name, schedule, status, terminationProtection)
+}
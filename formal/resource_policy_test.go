@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccPolicy_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_policy", "core.v1.Policy"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccPolicyConfig(name, "draft", "block", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_policy.test", "core.v1.Policy"),
+					resource.TestCheckResourceAttr("formal_policy.test", "name", name),
+					resource.TestCheckResourceAttr("formal_policy.test", "status", "draft"),
+					resource.TestCheckResourceAttr("formal_policy.test", "module", testAccPolicyCode("block")),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccPolicyConfig(name+"-renamed", "active", "allow", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_policy.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_policy.test", "status", "active"),
+					resource.TestCheckResourceAttr("formal_policy.test", "module", testAccPolicyCode("allow")),
+				),
+			},
+			{
+				ResourceName:      "formal_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPolicyConfig(name, status, action string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_policy" "test" {
+  name                   = %q
+  description            = "Created by acceptance tests"
+  status                 = %q
+  module                 = %q
+  termination_protection = %t
+}
+`, name, status, testAccPolicyCode(action), terminationProtection)
+}
+
+func testAccPolicyCode(action string) string {
+	return fmt.Sprintf("package formal.v2\n\nimport future.keywords.if\n\npre_request := {\"action\": %q} if { false }\n", action)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccResourceClassifierConfiguration_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_resource_classifier_configuration", "core.v1.ResourceClassifierConfiguration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccResourceClassifierConfigurationConfig(name, "nlp", "request", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_resource_classifier_configuration.test", "core.v1.ResourceClassifierConfiguration"),
+					resource.TestCheckResourceAttrPair("formal_resource_classifier_configuration.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttr("formal_resource_classifier_configuration.test", "preference", "nlp"),
+					resource.TestCheckResourceAttr("formal_resource_classifier_configuration.test", "ai_analysis_scope", "request"),
+					resource.TestCheckResourceAttr("formal_resource_classifier_configuration.test", "ai_analysis_timeout_seconds", "10"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceClassifierConfigurationConfig(name, "both", "response", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_resource_classifier_configuration.test", "preference", "both"),
+					resource.TestCheckResourceAttr("formal_resource_classifier_configuration.test", "ai_analysis_scope", "response"),
+					resource.TestCheckResourceAttr("formal_resource_classifier_configuration.test", "ai_analysis_timeout_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:      "formal_resource_classifier_configuration.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromAttribute("formal_resource_classifier_configuration.test", "resource_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceClassifierConfigurationConfig(name, preference, scope string, timeout int) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_resource_classifier_configuration" "test" {
+  resource_id                 = formal_resource.test.id
+  preference                  = %q
+  ai_analysis_scope           = %q
+  ai_analysis_timeout_seconds = %d
+}
+`, preference, scope, timeout)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccResourceDialConfiguration_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_resource_dial_configuration", "core.v1.ResourceDialConfiguration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccResourceDialConfigurationConfig(name, "tcp", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_resource_dial_configuration.test", "core.v1.ResourceDialConfiguration"),
+					resource.TestCheckResourceAttrPair("formal_resource_dial_configuration.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttr("formal_resource_dial_configuration.test", "dial_method", "tcp"),
+					resource.TestCheckResourceAttr("formal_resource_dial_configuration.test", "dial_target", ""),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceDialConfigurationConfig(name, "gcp_cloudsql", "project:us-central1:instance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_resource_dial_configuration.test", "dial_method", "gcp_cloudsql"),
+					resource.TestCheckResourceAttr("formal_resource_dial_configuration.test", "dial_target", "project:us-central1:instance"),
+				),
+			},
+			{
+				ResourceName:      "formal_resource_dial_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceDialConfigurationConfig(name, dialMethod, dialTarget string) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_resource_dial_configuration" "test" {
+  resource_id = formal_resource.test.id
+  dial_method = %q
+  dial_target = %q
+}
+`, dialMethod, dialTarget)
+}
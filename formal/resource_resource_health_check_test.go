@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccResourceHealthCheck_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_resource_health_check", "core.v1.ResourceHealthCheck"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccResourceHealthCheckConfig(name, "postgres"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_resource_health_check.test", "core.v1.ResourceHealthCheck"),
+					resource.TestCheckResourceAttrPair("formal_resource_health_check.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttr("formal_resource_health_check.test", "database_name", "postgres"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceHealthCheckConfig(name, "main"),
+				Check:  resource.TestCheckResourceAttr("formal_resource_health_check.test", "database_name", "main"),
+			},
+			{
+				ResourceName:      "formal_resource_health_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceHealthCheckConfig(name, databaseName string) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_resource_health_check" "test" {
+  resource_id   = formal_resource.test.id
+  database_name = %q
+}
+`, databaseName)
+}
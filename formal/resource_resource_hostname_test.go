@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccResourceHostname_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_resource_hostname", "core.v1.ResourceHostname"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccResourceHostnameConfig(name, "test", "replica.example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_resource_hostname.test", "core.v1.ResourceHostname"),
+					testAccStoreID("formal_resource_hostname.test", &id),
+					resource.TestCheckResourceAttrPair("formal_resource_hostname.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttr("formal_resource_hostname.test", "hostname", "replica.example.com"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceHostnameConfig(name, "test", "replica-2.example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("formal_resource_hostname.test", "id", &id),
+					resource.TestCheckResourceAttr("formal_resource_hostname.test", "hostname", "replica-2.example.com"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceHostnameConfig(name, "other", "replica-2.example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_resource_hostname.test", &id),
+					resource.TestCheckResourceAttrPair("formal_resource_hostname.test", "resource_id", "formal_resource.other", "id"),
+				),
+			},
+			{
+				ResourceName:      "formal_resource_hostname.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// termination_protection isn't returned by the API.
+				ImportStateVerifyIgnore: []string{"termination_protection"},
+			},
+			{
+				ResourceName:            "formal_resource_hostname.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIDFromKey("formal_resource_hostname.test", "resource_id", "hostname"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"termination_protection"},
+			},
+		},
+	})
+}
+
+// testAccResourceHostnameConfig attaches the hostname to the formal_resource
+// named test or other.
+func testAccResourceHostnameConfig(name, resourceName, hostname string, terminationProtection bool) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_resource" "other" {
+  name       = "%[1]s-other"
+  technology = "postgres"
+  hostname   = "other.example.com"
+  port       = 5432
+}
+
+resource "formal_resource_hostname" "test" {
+  resource_id            = formal_resource.%[2]s.id
+  name                   = %[1]q
+  hostname               = %[3]q
+  termination_protection = %[4]t
+}
+`, name, resourceName, hostname, terminationProtection)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+const (
+	testAccSSHHostKey      = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFirstKeyForAcceptanceTests"
+	testAccSSHHostKeyOther = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAISecondKeyForAcceptanceTests"
+)
+
+func TestAccResourceSSHHostKey_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_resource_ssh_host_key", "core.v1.ResourceSshHostKey"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccResourceSSHHostKeyConfig(name, "test", testAccSSHHostKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_resource_ssh_host_key.test", "core.v1.ResourceSshHostKey"),
+					testAccStoreID("formal_resource_ssh_host_key.test", &id),
+					resource.TestCheckResourceAttrPair("formal_resource_ssh_host_key.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttr("formal_resource_ssh_host_key.test", "public_key", testAccSSHHostKey),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceSSHHostKeyConfig(name, "test", testAccSSHHostKeyOther),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("formal_resource_ssh_host_key.test", "id", &id),
+					resource.TestCheckResourceAttr("formal_resource_ssh_host_key.test", "public_key", testAccSSHHostKeyOther),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceSSHHostKeyConfig(name, "other", testAccSSHHostKeyOther),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_resource_ssh_host_key.test", &id),
+					resource.TestCheckResourceAttrPair("formal_resource_ssh_host_key.test", "resource_id", "formal_resource.other", "id"),
+				),
+			},
+			{
+				ResourceName:      "formal_resource_ssh_host_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccResourceSSHHostKeyConfig attaches the key to the formal_resource
+// named test or other.
+func testAccResourceSSHHostKeyConfig(name, resourceName, publicKey string) string {
+	return testAccSSHResourcesConfig(name) + fmt.Sprintf(`
+resource "formal_resource_ssh_host_key" "test" {
+  resource_id = formal_resource.%s.id
+  public_key  = %q
+}
+`, resourceName, publicKey)
+}
+
+func testAccSSHResourcesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "formal_resource" "test" {
+  name       = %[1]q
+  technology = "ssh"
+  hostname   = "ssh.example.com"
+  port       = 22
+}
+
+resource "formal_resource" "other" {
+  name       = "%[1]s-other"
+  technology = "ssh"
+  hostname   = "ssh-2.example.com"
+  port       = 22
+}
+`, name)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccResource_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_resource", "core.v1.Resource"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "formal_resource" "test" {
+  name       = %q
+  technology = "postgres"
+  hostname   = "postgres.example.com"
+  port       = 5432
+  aliases    = ["pg"]
+
+  tags = {
+    team = "data"
+  }
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_resource.test", "core.v1.Resource"),
+					testAccStoreID("formal_resource.test", &id),
+					resource.TestCheckResourceAttr("formal_resource.test", "name", name),
+					resource.TestCheckResourceAttr("formal_resource.test", "technology", "postgres"),
+					resource.TestCheckResourceAttr("formal_resource.test", "hostname", "postgres.example.com"),
+					resource.TestCheckResourceAttr("formal_resource.test", "port", "5432"),
+					resource.TestCheckTypeSetElemAttr("formal_resource.test", "aliases.*", "pg"),
+					resource.TestCheckResourceAttr("formal_resource.test", "tags.team", "data"),
+					resource.TestCheckResourceAttr("formal_resource.test", "tags_all.team", "data"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "formal_resource" "test" {
+  name       = "%s-renamed"
+  technology = "postgres"
+  hostname   = "postgres-2.example.com"
+  port       = 5433
+  aliases    = ["pg", "postgres"]
+
+  tags = {
+    team = "platform"
+  }
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("formal_resource.test", "id", &id),
+					resource.TestCheckResourceAttr("formal_resource.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_resource.test", "hostname", "postgres-2.example.com"),
+					resource.TestCheckResourceAttr("formal_resource.test", "port", "5433"),
+					resource.TestCheckResourceAttr("formal_resource.test", "aliases.#", "2"),
+					resource.TestCheckResourceAttr("formal_resource.test", "tags.team", "platform"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceConfig(name, "mysql", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_resource.test", &id),
+					resource.TestCheckResourceAttr("formal_resource.test", "technology", "mysql"),
+				),
+			},
+			{
+				ResourceName:      "formal_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceConfig(name, technology string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_resource" "test" {
+  name                   = %q
+  technology             = %q
+  hostname               = "db.example.com"
+  port                   = 5432
+  termination_protection = %t
+}
+`, name, technology, terminationProtection)
+}
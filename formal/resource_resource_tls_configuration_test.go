@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccResourceTLSConfiguration_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_resource_tls_configuration", "core.v1.ResourceTlsConfiguration"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccResourceTLSConfigurationConfig(name, "verify-full", "TLSv1.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_resource_tls_configuration.test", "core.v1.ResourceTlsConfiguration"),
+					resource.TestCheckResourceAttrPair("formal_resource_tls_configuration.test", "resource_id", "formal_resource.test", "id"),
+					resource.TestCheckResourceAttr("formal_resource_tls_configuration.test", "tls_config", "verify-full"),
+					resource.TestCheckResourceAttr("formal_resource_tls_configuration.test", "tls_min_version", "TLSv1.3"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceTLSConfigurationConfig(name, "insecure-skip-verify", "TLSv1.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_resource_tls_configuration.test", "tls_config", "insecure-skip-verify"),
+					resource.TestCheckResourceAttr("formal_resource_tls_configuration.test", "tls_min_version", "TLSv1.2"),
+				),
+			},
+			{
+				ResourceName:      "formal_resource_tls_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTLSConfigurationConfig(name, tlsConfig, tlsMinVersion string) string {
+	return testAccResourceConfig(name, "postgres", false) + fmt.Sprintf(`
+resource "formal_resource_tls_configuration" "test" {
+  resource_id     = formal_resource.test.id
+  tls_config      = %q
+  tls_min_version = %q
+}
+`, tlsConfig, tlsMinVersion)
+}
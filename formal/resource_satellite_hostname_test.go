@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccSatelliteHostname_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	satellite := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_satellite_hostname", "core.v1.SatelliteHostname"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccSatelliteHostnameConfig(satellite, "satellite.example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_satellite_hostname.test", "core.v1.SatelliteHostname"),
+					testAccStoreID("formal_satellite_hostname.test", &id),
+					resource.TestCheckResourceAttrPair("formal_satellite_hostname.test", "satellite_id", "formal_satellite.test", "id"),
+					resource.TestCheckResourceAttr("formal_satellite_hostname.test", "hostname", "satellite.example.com"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccSatelliteHostnameConfig(satellite, "satellite-2.example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_satellite_hostname.test", &id),
+					resource.TestCheckResourceAttr("formal_satellite_hostname.test", "hostname", "satellite-2.example.com"),
+				),
+			},
+			{
+				ResourceName:      "formal_satellite_hostname.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSatelliteHostnameConfig(satellite, hostname string, terminationProtection bool) string {
+	return testAccSatelliteConfig(satellite, false) + fmt.Sprintf(`
+resource "formal_satellite_hostname" "test" {
+  satellite_id           = formal_satellite.test.id
+  hostname               = %q
+  termination_protection = %t
+}
+`, hostname, terminationProtection)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccSatelliteLink_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_satellite_link", "core.v1.SatelliteLink"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccSatelliteLinkConfig(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_satellite_link.test", "core.v1.SatelliteLink"),
+					testAccStoreID("formal_satellite_link.test", &id),
+					resource.TestCheckResourceAttrPair("formal_satellite_link.test", "source_satellite_id", "formal_satellite.source", "id"),
+					resource.TestCheckResourceAttrPair("formal_satellite_link.test", "target_satellite_id", "formal_satellite.first", "id"),
+					resource.TestCheckResourceAttrSet("formal_satellite_link.test", "created_at"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccSatelliteLinkConfig(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_satellite_link.test", &id),
+					resource.TestCheckResourceAttrPair("formal_satellite_link.test", "target_satellite_id", "formal_satellite.second", "id"),
+				),
+			},
+			{
+				ResourceName:      "formal_satellite_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSatelliteLinkConfig(name, target string) string {
+	return fmt.Sprintf(`
+resource "formal_satellite" "source" {
+  name           = "%[1]s-source"
+  satellite_type = "data_discovery"
+}
+
+resource "formal_satellite" "first" {
+  name           = "%[1]s-first"
+  satellite_type = "data_classifier"
+}
+
+resource "formal_satellite" "second" {
+  name           = "%[1]s-second"
+  satellite_type = "data_classifier"
+}
+
+resource "formal_satellite_link" "test" {
+  source_satellite_id = formal_satellite.source.id
+  target_satellite_id = formal_satellite.%[2]s.id
+}
+`, name, target)
+}
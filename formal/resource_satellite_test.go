@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccSatellite_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_satellite", "core.v1.Satellite"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccSatelliteConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_satellite.test", "core.v1.Satellite"),
+					testAccStoreID("formal_satellite.test", &id),
+					resource.TestCheckResourceAttr("formal_satellite.test", "name", name),
+					resource.TestCheckResourceAttr("formal_satellite.test", "satellite_type", "data_discovery"),
+					resource.TestCheckResourceAttr("formal_satellite.test", "api_key", "fake-api-key"),
+					resource.TestCheckResourceAttr("formal_satellite.test", "tls_cert", ""),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccSatelliteConfig(name+"-renamed", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_satellite.test", &id),
+					resource.TestCheckResourceAttr("formal_satellite.test", "name", name+"-renamed"),
+				),
+			},
+			{
+				ResourceName:      "formal_satellite.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSatelliteConfig(name string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_satellite" "test" {
+  name                   = %q
+  satellite_type         = "data_discovery"
+  termination_protection = %t
+}
+`, name, terminationProtection)
+}
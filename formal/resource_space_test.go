@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccSpace_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_space", "core.v1.Space"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccSpaceConfig(name, "Created by acceptance tests", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_space.test", "core.v1.Space"),
+					resource.TestCheckResourceAttr("formal_space.test", "name", name),
+					resource.TestCheckResourceAttr("formal_space.test", "description", "Created by acceptance tests"),
+					resource.TestCheckResourceAttrSet("formal_space.test", "created_at"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccSpaceConfig(name+"-renamed", "Updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_space.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_space.test", "description", "Updated"),
+				),
+			},
+			{
+				ResourceName:      "formal_space.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSpaceConfig(name, description string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_space" "test" {
+  name                   = %q
+  description            = %q
+  termination_protection = %t
+}
+`, name, description, terminationProtection)
+}
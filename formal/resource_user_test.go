@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccUser_human(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	email := testAccName() + "@example.com"
+	renamed := testAccName() + "@example.com"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_user", "core.v1.User"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccUserHumanConfig(email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_user.test", "core.v1.User"),
+					testAccStoreID("formal_user.test", &id),
+					resource.TestCheckResourceAttr("formal_user.test", "type", "human"),
+					resource.TestCheckResourceAttr("formal_user.test", "first_name", "Jane"),
+					resource.TestCheckResourceAttr("formal_user.test", "email", email),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccUserHumanConfig(renamed, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaced("formal_user.test", &id),
+					resource.TestCheckResourceAttr("formal_user.test", "email", renamed),
+				),
+			},
+			{
+				ResourceName:      "formal_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUser_machine(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_user", "core.v1.User"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccUserMachineConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_user.test", "type", "machine"),
+					resource.TestCheckResourceAttr("formal_user.test", "name", name),
+					resource.TestCheckResourceAttrSet("formal_user.test", "machine_user_access_token"),
+				),
+			},
+			{
+				ResourceName:      "formal_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserHumanConfig(email string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "formal_user" "test" {
+  type                   = "human"
+  first_name             = "Jane"
+  last_name              = "Doe"
+  email                  = %q
+  termination_protection = %t
+}
+`, email, terminationProtection)
+}
+
+func testAccUserMachineConfig(name string) string {
+	return fmt.Sprintf(`
+resource "formal_user" "test" {
+  type = "machine"
+  name = %q
+}
+`, name)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+func TestAccWorkflow_basic(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	name := testAccName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(server, "formal_workflow", "core.v1.Workflow"),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccWorkflowConfig(name, "draft"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(server, "formal_workflow.test", "core.v1.Workflow"),
+					resource.TestCheckResourceAttr("formal_workflow.test", "name", name),
+					resource.TestCheckResourceAttr("formal_workflow.test", "status", "draft"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testAccWorkflowConfig(name+"-renamed", "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("formal_workflow.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("formal_workflow.test", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "formal_workflow.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWorkflowConfig(name, status string) string {
+	return fmt.Sprintf(`
+resource "formal_workflow" "test" {
+  name   = %q
+  status = %q
+  code   = <<-YAML
+    name: %s
+    trigger:
+      type: manual
+  YAML
+}
+`, name, status, name)
+}
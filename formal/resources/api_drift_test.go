@@ -0,0 +1,281 @@
+package resource
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+)
+
+// The tests in this file compare the provider schemas with the API protos, so
+// that bumping the SDK surfaces attributes and enum values the provider lacks
+// or still accepts after the API dropped them.
+
+// apiEnumCases pairs the values accepted by a schema attribute with the
+// buf.validate constraint of the request field it is sent as.
+var apiEnumCases = []struct {
+	name    string
+	message proto.Message
+	// path is the dotted path of the field from message, descending into
+	// message and repeated message fields.
+	path string
+	in   func(*validate.FieldRules) []string
+	want []string
+	// ordered requires want in the order of the API, for the lists the
+	// provider keeps in sync with the proto rather than building from a map.
+	ordered bool
+	// validate, if set, is the ValidateFunc of the attribute, which must
+	// accept every value of the API.
+	validate schema.SchemaValidateFunc
+}{
+	{
+		name:     "resource technology",
+		message:  &corev1.CreateResourceRequest{},
+		path:     "technology",
+		in:       stringIn,
+		want:     resourceTechnologies,
+		ordered:  true,
+		validate: ResourceResource().Schema["technology"].ValidateFunc,
+	},
+	{
+		name:    "form field type",
+		message: &corev1.CreateFormRequest{},
+		path:    "fields.type",
+		in:      stringIn,
+		want:    validFormFieldTypes,
+	},
+	{
+		name:    "satellite type",
+		message: &corev1.CreateSatelliteRequest{},
+		path:    "satellite_type",
+		in:      stringIn,
+		want:    satelliteTypes,
+	},
+	{
+		name:    "log request retention",
+		message: &corev1.CreateLogConfigurationRequest{},
+		path:    "request.policy_eval_input_retention",
+		in:      durationIn,
+		want:    slices.Collect(maps.Keys(logRetentionDurations)),
+	},
+	{
+		name:    "log response retention",
+		message: &corev1.CreateLogConfigurationRequest{},
+		path:    "response.policy_eval_input_retention",
+		in:      durationIn,
+		want:    slices.Collect(maps.Keys(logRetentionDurations)),
+	},
+	{
+		name:    "log session retention",
+		message: &corev1.CreateLogConfigurationRequest{},
+		path:    "session.policy_eval_input_retention",
+		in:      durationIn,
+		want:    slices.Collect(maps.Keys(logRetentionDurations)),
+	},
+}
+
+func TestEnumsMatchAPI(t *testing.T) {
+	for _, tc := range apiEnumCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := fieldRules(t, tc.message, tc.path)
+			in := tc.in(rules)
+			require.NotEmpty(t, in, "%s has no buf.validate in constraint", tc.path)
+			if tc.ordered {
+				require.Equal(t, in, tc.want)
+			} else {
+				require.ElementsMatch(t, in, tc.want)
+			}
+			if tc.validate == nil {
+				return
+			}
+			for _, value := range in {
+				warnings, errors := tc.validate(value, tc.path)
+				require.Empty(t, warnings, value)
+				require.Empty(t, errors, value)
+			}
+		})
+	}
+}
+
+// apiPatternCases pairs the provider's regexps with the buf.validate pattern of
+// the request field they validate.
+var apiPatternCases = []struct {
+	name    string
+	message proto.Message
+	path    string
+	want    string
+}{
+	{
+		// The rule pattern is pinned to the backend set of technologies.
+		name:    "connector listener rule",
+		message: &corev1.CreateConnectorListenerRuleRequest{},
+		path:    "rule",
+		want:    connectorListenerRuleValuePattern.String(),
+	},
+}
+
+func TestPatternsMatchAPI(t *testing.T) {
+	for _, tc := range apiPatternCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pattern := fieldRules(t, tc.message, tc.path).GetString().GetPattern()
+			require.NotEmpty(t, pattern, "%s has no buf.validate pattern", tc.path)
+			require.Equal(t, pattern, tc.want)
+		})
+	}
+}
+
+// createRequestCases pairs each resource with the request its Create sends.
+var createRequestCases = []struct {
+	resource *schema.Resource
+	request  proto.Message
+	// renamed maps request fields to the differently named attribute setting them.
+	renamed map[string]string
+	// flattened lists message fields whose own fields are attributes of the
+	// resource rather than a block named after the field.
+	flattened []string
+}{
+	{resource: ResourceConnector(), request: &corev1.CreateConnectorRequest{}},
+	{resource: ResourceConnectorAiProvider(), request: &corev1.CreateConnectorAiProviderRequest{}, flattened: []string{"config"}},
+	{resource: ResourceConnectorConfiguration(), request: &corev1.CreateConnectorConfigurationRequest{}},
+	{resource: ResourceConnectorHostname(), request: &corev1.CreateConnectorHostnameRequest{}},
+	{resource: ResourceConnectorListener(), request: &corev1.CreateConnectorListenerRequest{}},
+	{resource: ResourceConnectorListenerLink(), request: &corev1.CreateConnectorListenerLinkRequest{}},
+	{resource: ResourceConnectorListenerRule(), request: &corev1.CreateConnectorListenerRuleRequest{}},
+	{resource: ResourceConnectorSatelliteLink(), request: &corev1.CreateConnectorSatelliteLinkRequest{}},
+	{resource: ResourceConnectorTokenEncryptionKey(), request: &corev1.CreateConnectorTokenEncryptionKeyRequest{}, renamed: map[string]string{"provider": "key_provider"}},
+	{resource: ResourceDataDiscovery(), request: &corev1.CreateDataDiscoveryConfigurationRequest{}},
+	{resource: ResourceDataLabel(), request: &corev1.CreateDataLabelRequest{}},
+	{resource: ResourceDialConfiguration(), request: &corev1.CreateResourceDialConfigurationRequest{}},
+	{resource: ResourceEncryptionKey(), request: &corev1.CreateEncryptionKeyRequest{}, renamed: map[string]string{"provider": "key_provider"}},
+	{resource: ResourceForm(), request: &corev1.CreateFormRequest{}, renamed: map[string]string{"fields": "field"}},
+	{resource: ResourceGroup(), request: &corev1.CreateGroupRequest{}},
+	{resource: ResourceGroupLinkUser(), request: &corev1.CreateUserGroupLinkRequest{}},
+	{resource: ResourceHealthCheck(), request: &corev1.CreateResourceHealthCheckRequest{}},
+	{resource: ResourceIntegrationBI(), request: &corev1.CreateBIIntegrationRequest{}},
+	{resource: ResourceIntegrationCloud(), request: &corev1.CreateCloudIntegrationRequest{}},
+	{resource: ResourceIntegrationLogs(), request: &corev1.CreateIntegrationLogRequest{}},
+	{resource: ResourceIntegrationMDM(), request: &corev1.CreateIntegrationMDMRequest{}},
+	{resource: ResourceIntegrationOIDC(), request: &corev1.CreateIntegrationOIDCRequest{}},
+	{
+		resource:  ResourceInventoryObject(),
+		request:   &corev1.CreateInventoryObjectRequest{},
+		renamed:   map[string]string{"datastore_id": "resource_id", "object_type": "type"},
+		flattened: []string{"db", "schema", "table", "column", "sub_column"},
+	},
+	{resource: ResourceLogConfiguration(), request: &corev1.CreateLogConfigurationRequest{}},
+	{
+		resource: ResourceNativeUser(),
+		request:  &corev1.CreateNativeUserRequest{},
+		renamed:  map[string]string{"username": "native_user_id", "secret": "native_user_secret"},
+	},
+	{
+		resource: ResourceNativeUserLink(),
+		request:  &corev1.CreateNativeUserIdentityLinkRequest{},
+		renamed:  map[string]string{"identity_id": "formal_identity_id", "identity_type": "formal_identity_type"},
+	},
+	{resource: ResourceNetworkRule(), request: &corev1.CreateDesktopRoutingRuleRequest{}},
+	{resource: ResourcePermission(), request: &corev1.CreatePermissionRequest{}},
+	{resource: ResourcePolicyDataLoader(), request: &corev1.CreatePolicyDataLoaderRequest{}},
+	{resource: ResourceResource(), request: &corev1.CreateResourceRequest{}},
+	{
+		resource: ResourceResourceClassifierConfiguration(),
+		request:  &corev1.CreateResourceClassifierConfigurationRequest{},
+		renamed:  map[string]string{"strict_classifier_result_count": "enforce_strict_classifier_result_count"},
+	},
+	{resource: ResourceResourceHostname(), request: &corev1.CreateResourceHostnameRequest{}},
+	{resource: ResourceSatellite(), request: &corev1.CreateSatelliteRequest{}},
+	{resource: ResourceSatelliteHostname(), request: &corev1.CreateSatelliteHostnameRequest{}},
+	{resource: ResourceSatelliteLink(), request: &corev1.CreateSatelliteLinkRequest{}},
+	{resource: ResourceSpace(), request: &corev1.CreateSpaceRequest{}},
+	{resource: ResourceSshHostKey(), request: &corev1.CreateResourceSshHostKeyRequest{}},
+	{resource: ResourceTlsConfiguration(), request: &corev1.CreateResourceTlsConfigurationRequest{}},
+	{resource: ResourceUser(), request: &corev1.CreateUserRequest{}, flattened: []string{"human", "machine"}},
+	{resource: ResourceWorkflow(), request: &corev1.CreateWorkflowRequest{}},
+}
+
+func TestCreateRequestFieldsHaveAttributes(t *testing.T) {
+	for _, tc := range createRequestCases {
+		desc := tc.request.ProtoReflect().Descriptor()
+		t.Run(string(desc.Name()), func(t *testing.T) {
+			for _, name := range tc.flattened {
+				require.NotNil(t, desc.Fields().ByName(protoreflect.Name(name)), "flattened field %s not found", name)
+			}
+			for name, attr := range tc.renamed {
+				require.NotNil(t, desc.Fields().ByName(protoreflect.Name(name)), "renamed field %s not found", name)
+				require.Contains(t, tc.resource.Schema, attr, "renamed field %s has no %s attribute", name, attr)
+			}
+
+			var missing []string
+			for _, fd := range requestFields(desc, tc.flattened) {
+				name := string(fd.Name())
+				if _, ok := tc.renamed[name]; ok {
+					continue
+				}
+				if _, ok := tc.resource.Schema[name]; !ok {
+					missing = append(missing, string(fd.FullName()))
+				}
+			}
+			require.Empty(t, missing, "request fields without a schema attribute")
+		})
+	}
+}
+
+// requestFields returns the fields of desc, replacing the flattened message
+// fields with their own fields.
+func requestFields(desc protoreflect.MessageDescriptor, flattened []string) []protoreflect.FieldDescriptor {
+	var fields []protoreflect.FieldDescriptor
+	for i := range desc.Fields().Len() {
+		fd := desc.Fields().Get(i)
+		if slices.Contains(flattened, string(fd.Name())) && fd.Message() != nil {
+			fields = append(fields, requestFields(fd.Message(), nil)...)
+			continue
+		}
+		fields = append(fields, fd)
+	}
+	return fields
+}
+
+// fieldRules returns the buf.validate rules of the field at path in message.
+func fieldRules(t *testing.T, message proto.Message, path string) *validate.FieldRules {
+	t.Helper()
+
+	desc := message.ProtoReflect().Descriptor()
+	names := strings.Split(path, ".")
+	var fd protoreflect.FieldDescriptor
+	for i, name := range names {
+		fd = desc.Fields().ByName(protoreflect.Name(name))
+		require.NotNil(t, fd, "%s field not found in %s", name, desc.FullName())
+		if i < len(names)-1 {
+			desc = fd.Message()
+			require.NotNil(t, desc, "%s is not a message field", name)
+		}
+	}
+
+	rules, ok := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldRules)
+	require.True(t, ok, "%s field has no buf.validate rules", path)
+	return rules
+}
+
+func stringIn(rules *validate.FieldRules) []string {
+	return rules.GetString().GetIn()
+}
+
+// durationIn formats the allowed durations as whole days, the format of the
+// provider's duration attributes.
+func durationIn(rules *validate.FieldRules) []string {
+	var in []string
+	for _, d := range rules.GetDuration().GetIn() {
+		in = append(in, fmt.Sprintf("%dd", d.AsDuration()/(24*time.Hour)))
+	}
+	return in
+}
@@ -0,0 +1,30 @@
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// idIdentity is the identity of the resources identified by their ID alone,
+// with which Terraform imports the instances its list resources return.
+func idIdentity(object string) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"id": {
+					Description:       "The ID of the " + object + ".",
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				},
+			}
+		},
+	}
+}
+
+// setIDIdentity sets the identity of a resource with an idIdentity to its ID.
+func setIDIdentity(d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	return identity.Set("id", d.Id())
+}
@@ -0,0 +1,68 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+// importByKey returns an importer accepting either the ID of an object, or
+// its natural key: the values of the given attributes joined by slashes, for
+// example group_id/user_id. The last value may contain slashes itself.
+//
+// find returns the ID of the object with the key values, in the order of the
+// attributes, or "" if there's none. The importer sets the attributes of the
+// key, and Read the rest of the state.
+func importByKey(object string, attributes []string, find func(ctx context.Context, c *clients.Clients, key []string) (string, error)) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if !strings.Contains(d.Id(), "/") {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		format := strings.Join(attributes, "/")
+		key := strings.SplitN(d.Id(), "/", len(attributes))
+		if len(key) != len(attributes) || slices.Contains(key, "") {
+			return nil, fmt.Errorf("unexpected import ID %q: expected the ID of the %s or %s", d.Id(), object, format)
+		}
+
+		id, err := find(ctx, meta.(*clients.Clients), key)
+		if err != nil {
+			return nil, err
+		}
+		if id == "" {
+			return nil, fmt.Errorf("no %s found with %s %s", object, format, d.Id())
+		}
+
+		for i, attribute := range attributes {
+			if err := d.Set(attribute, key[i]); err != nil {
+				return nil, err
+			}
+		}
+		d.SetId(id)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// keyFilter returns the List filter on one value of a natural key, so that
+// find only pages through the objects sharing it.
+func keyFilter(key, value string) (*corev1.Filter, error) {
+	filterValue, err := anypb.New(&wrapperspb.StringValue{Value: value})
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Filter{
+		Field: &corev1.Field{
+			Key:      key,
+			Operator: "equals",
+			Value:    filterValue,
+		},
+	}, nil
+}
@@ -22,6 +22,7 @@ func ResourceConnector() *schema.Resource {
 		ReadContext:   resourceConnectorRead,
 		UpdateContext: resourceConnectorUpdate,
 		DeleteContext: resourceConnectorDelete,
+		CustomizeDiff: customizeDiffDefaultSpaceID,
 		SchemaVersion: 1,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(25 * time.Minute),
@@ -58,10 +59,10 @@ func ResourceConnector() *schema.Resource {
 			},
 			"space_id": {
 				// This description is used by the documentation generator and the language server.
-				Description: "The ID of the Space to create the Connector in.",
+				Description: "The ID of the Space to create the Connector in. Defaults to the provider `default_space_id`.",
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
+				Computed:    true,
 			},
 		},
 	}
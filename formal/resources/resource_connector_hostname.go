@@ -194,6 +194,10 @@ func resourceConnectorHostnameRead(ctx context.Context, d *schema.ResourceData,
 
 	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
+		if d.Id() == "" {
+			// The hostname was deleted outside of Terraform.
+			return diags
+		}
 		return diag.Errorf("Error waiting for TLS certificate and DNS record to become active: %s", err)
 	}
 
@@ -12,6 +12,7 @@ import (
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
 )
 
 func ResourceConnectorListenerLink() *schema.Resource {
@@ -28,7 +29,7 @@ func ResourceConnectorListenerLink() *schema.Resource {
 			Create: schema.DefaultTimeout(25 * time.Minute),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByKey("connector listener link", []string{"connector_id", "connector_listener_id"}, findConnectorListenerLink),
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -119,6 +120,30 @@ func resourceConnectorListenerLinkRead(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+// findConnectorListenerLink returns the ID of the link between the connector
+// and the connector listener of key.
+func findConnectorListenerLink(ctx context.Context, c *clients.Clients, key []string) (string, error) {
+	connectorId, connectorListenerId := key[0], key[1]
+	filter, err := keyFilter("connector_id", connectorId)
+	if err != nil {
+		return "", err
+	}
+	link, _, err := paging.Find(ctx, func(ctx context.Context, cursor string) ([]*corev1.ConnectorListenerLink, string, error) {
+		res, err := c.Grpc.Sdk.ConnectorServiceClient.ListConnectorListenerLinks(ctx, &corev1.ListConnectorListenerLinksRequest{
+			Filter: filter,
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.ConnectorListenerLinks, res.NextCursor, nil
+	}, func(link *corev1.ConnectorListenerLink) bool {
+		return link.Connector.GetId() == connectorId && link.Listener.GetId() == connectorListenerId
+	})
+	return link.GetId(), err
+}
+
 func resourceConnectorListenerLinkUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// use the meta value to retrieve your client from the provider configure method
 	c := meta.(*clients.Clients)
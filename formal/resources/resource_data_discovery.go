@@ -6,10 +6,11 @@ import (
 	"strings"
 	"time"
 
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/robfig/cron/v3"
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
@@ -63,17 +64,7 @@ func ResourceDataDiscovery() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
-					v := val.(string)
-					predefinedSchedules := map[string]bool{
-						"6h":  true,
-						"12h": true,
-						"18h": true,
-						"24h": true,
-					}
-					if predefinedSchedules[v] {
-						return warns, errs
-					}
-					if _, err := cron.ParseStandard(v); err != nil {
+					if err := ValidateSchedule(val.(string)); err != nil {
 						errs = append(errs, fmt.Errorf("%q must be a valid cron expression or one of the predefined schedules ('6h', '12h', '18h', '24h')", key))
 					}
 					return warns, errs
@@ -142,6 +133,11 @@ func resourceDataDiscoveryRead(ctx context.Context, d *schema.ResourceData, meta
 
 	res, err := c.Grpc.Sdk.ResourceServiceClient.GetDataDiscoveryConfiguration(ctx, &corev1.GetDataDiscoveryConfigurationRequest{Id: &id})
 	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			tflog.Warn(ctx, "The Data Discovery configuration was not found, which means it may have been deleted without using this Terraform config.", map[string]any{"err": err})
+			d.SetId("")
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 
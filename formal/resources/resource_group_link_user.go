@@ -10,6 +10,7 @@ import (
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
 )
 
 func ResourceGroupLinkUser() *schema.Resource {
@@ -22,7 +23,7 @@ func ResourceGroupLinkUser() *schema.Resource {
 		UpdateContext: resourceGroupLinkUserUpdate,
 		DeleteContext: resourceGroupLinkUserDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByKey("group user link", []string{"group_id", "user_id"}, findGroupLinkUser),
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -89,7 +90,9 @@ func resourceGroupLinkUserRead(ctx context.Context, d *schema.ResourceData, meta
 	userId := d.Get("user_id").(string)
 	groupId := d.Get("group_id").(string)
 
-	res, err := c.Grpc.Sdk.GroupServiceClient.ListUserGroupLinks(ctx, &corev1.ListUserGroupLinksRequest{GroupId: groupId, Limit: 500})
+	link, _, err := paging.Find(ctx, groupUserLinksPage(c, groupId), func(l *corev1.UserGroupLink) bool {
+		return l.Id == groupLinkId
+	})
 	if err != nil {
 		if connect.CodeOf(err) == connect.CodeNotFound {
 			// Link was deleted
@@ -99,28 +102,47 @@ func resourceGroupLinkUserRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 		return diag.FromErr(err)
 	}
-	found := false
-	for _, user := range res.UserGroupLinks {
-		if user.User.Id == groupLinkId {
-			found = true
-			break
-		}
-	}
 
-	if !found {
-		// Not found
+	if link == nil {
+		tflog.Warn(ctx, "The Group-User link was not found, which means it may have been deleted without using this Terraform config.", map[string]any{"group_id": groupId, "user_id": userId})
+		d.SetId("")
 		return diags
 	}
 
 	// Should map to all fields of
-	d.Set("group_id", groupId)
-	d.Set("user_id", userId)
+	d.Set("group_id", link.Group.Id)
+	d.Set("user_id", link.User.Id)
 
 	d.SetId(groupLinkId)
 
 	return diags
 }
 
+// findGroupLinkUser returns the ID of the link between the group and the user
+// of key.
+func findGroupLinkUser(ctx context.Context, c *clients.Clients, key []string) (string, error) {
+	groupId, userId := key[0], key[1]
+	link, _, err := paging.Find(ctx, groupUserLinksPage(c, groupId), func(link *corev1.UserGroupLink) bool {
+		return link.Group.GetId() == groupId && link.User.GetId() == userId
+	})
+	return link.GetId(), err
+}
+
+// groupUserLinksPage lists the links of the users of a group.
+func groupUserLinksPage(c *clients.Clients, groupId string) paging.Page[*corev1.UserGroupLink] {
+	return func(ctx context.Context, cursor string) ([]*corev1.UserGroupLink, string, error) {
+		res, err := c.Grpc.Sdk.GroupServiceClient.ListUserGroupLinks(ctx, &corev1.ListUserGroupLinksRequest{
+			GroupId: groupId,
+			Limit:   paging.PageSize,
+			Cursor:  cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.UserGroupLinks, res.NextCursor, nil
+	}
+}
+
 func resourceGroupLinkUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	return diag.Errorf("Group User Links are immutable. Please create a new roleLinkGroup.")
 }
@@ -2,9 +2,12 @@ package resource
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -28,8 +31,8 @@ func ResourceHealthCheck() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Version: 0,
-				Type:    resourcePolicyInstanceResourceV0().CoreConfigSchema().ImpliedType(),
-				Upgrade: resourcePolicyStateUpgradeV0,
+				Type:    resourceHealthCheckInstanceResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceHealthCheckStateUpgradeV0,
 			},
 		},
 		Schema: map[string]*schema.Schema{
@@ -110,6 +113,11 @@ func resourceHealthCheckRead(ctx context.Context, d *schema.ResourceData, meta a
 
 	res, err := c.Grpc.Sdk.ResourceServiceClient.GetResourceHealthCheck(ctx, &corev1.GetResourceHealthCheckRequest{Id: &id})
 	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			tflog.Warn(ctx, "The Resource Health Check was not found, which means it may have been deleted without using this Terraform config.", map[string]any{"err": err})
+			d.SetId("")
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 
@@ -164,3 +172,39 @@ func resourceHealthCheckDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+// resourceHealthCheckInstanceResourceV0 decodes version 0 states. The schema of
+// version 0 wasn't kept, so these are the attributes of version 1, of which a
+// version 0 state has at most a subset.
+func resourceHealthCheckInstanceResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"termination_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceHealthCheckStateUpgradeV0 keeps the state as is, and the next refresh
+// reads any attribute it lacks from the API.
+func resourceHealthCheckStateUpgradeV0(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+	if rawState == nil {
+		return nil, fmt.Errorf("health check resource state upgrade failed, state is nil")
+	}
+
+	return rawState, nil
+}
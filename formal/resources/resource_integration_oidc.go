@@ -14,11 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/api"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
 )
 
-const oidcAudiencePrefix = "oidc.formal.ai/"
-
 func ResourceIntegrationOIDC() *schema.Resource {
 	return &schema.Resource{
 		Description: "Registers an OIDC trust configuration used to authenticate externally signed JWTs to the Formal control plane as a machine user.",
@@ -159,7 +158,7 @@ func validateOIDCJWKSURI(v any, key string) (warns []string, errs []error) {
 }
 
 func oidcAudience(id string) string {
-	return oidcAudiencePrefix + id
+	return api.OIDCAudiencePrefix + id
 }
 
 func resourceIntegrationOIDCCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
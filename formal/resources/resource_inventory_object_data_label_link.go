@@ -11,6 +11,7 @@ import (
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
 )
 
 func ResourceInventoryObjectDataLabelLink() *schema.Resource {
@@ -23,7 +24,7 @@ func ResourceInventoryObjectDataLabelLink() *schema.Resource {
 		DeleteContext: resourceInventoryObjectDataLabelLinkDelete,
 		SchemaVersion: 1,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByKey("inventory object data label link", []string{"resource_id", "path"}, findInventoryObjectDataLabelLink),
 		},
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -119,6 +120,37 @@ func resourceInventoryObjectDataLabelLinkRead(ctx context.Context, d *schema.Res
 	return diags
 }
 
+// findInventoryObjectDataLabelLink returns the ID of the column or sub-column
+// of the resource at the path of key.
+func findInventoryObjectDataLabelLink(ctx context.Context, c *clients.Clients, key []string) (string, error) {
+	resourceId, path := key[0], key[1]
+	filter, err := keyFilter("path", path)
+	if err != nil {
+		return "", err
+	}
+	object, _, err := paging.Find(ctx, func(ctx context.Context, cursor string) ([]*corev1.InventoryObject, string, error) {
+		res, err := c.Grpc.Sdk.InventoryServiceClient.ListInventoryObjects(ctx, &corev1.ListInventoryObjectsRequest{
+			Filter:      filter,
+			DatastoreId: resourceId,
+			Limit:       paging.PageSize,
+			Cursor:      cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Objects, res.NextCursor, nil
+	}, func(object *corev1.InventoryObject) bool {
+		if col := object.GetColumn(); col != nil {
+			return col.ResourceId == resourceId && col.Path == path
+		}
+		if sub := object.GetSubColumn(); sub != nil {
+			return sub.ResourceId == resourceId && sub.Path == path
+		}
+		return false
+	})
+	return object.GetId(), err
+}
+
 func resourceInventoryObjectDataLabelLinkUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	c := meta.(*clients.Clients)
 
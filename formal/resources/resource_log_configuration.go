@@ -201,6 +201,18 @@ func ResourceLogConfiguration() *schema.Resource {
 	}
 }
 
+// logRetentionDurations maps the accepted policy_eval_input_retention values
+// to their length in days.
+var logRetentionDurations = map[string]int{
+	"1d":  1,
+	"2d":  2,
+	"3d":  3,
+	"7d":  7,
+	"14d": 14,
+	"21d": 21,
+	"30d": 30,
+}
+
 // parseDuration converts a duration string in format "%dd" (e.g., "1d", "7d", "30d") to a protobuf Duration.
 // Only accepts values: 1d, 2d, 3d, 7d, 14d, 21d, 30d
 // Returns nil if the input string is empty.
@@ -209,18 +221,7 @@ func parseDuration(durationStr string) (*durationpb.Duration, error) {
 		return nil, nil
 	}
 
-	// Valid duration values in days
-	validDurations := map[string]int{
-		"1d":  1,
-		"2d":  2,
-		"3d":  3,
-		"7d":  7,
-		"14d": 14,
-		"21d": 21,
-		"30d": 30,
-	}
-
-	days, ok := validDurations[durationStr]
+	days, ok := logRetentionDurations[durationStr]
 	if !ok {
 		return nil, fmt.Errorf("invalid duration '%s': must be one of 1d, 2d, 3d, 7d, 14d, 21d, 30d", durationStr)
 	}
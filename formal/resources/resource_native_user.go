@@ -23,8 +23,9 @@ func ResourceNativeUser() *schema.Resource {
 		UpdateContext: resourceNativeUserUpdate,
 		DeleteContext: resourceNativeUserDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
 		},
+		Identity: idIdentity("Native User"),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				// This description is used by the documentation generator and the language server.
@@ -161,6 +162,9 @@ func resourceNativeUserRead(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set("termination_protection", res.NativeUser.TerminationProtection)
 
 	d.SetId(res.NativeUser.Id)
+	if err := setIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
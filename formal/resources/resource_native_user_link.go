@@ -10,6 +10,7 @@ import (
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
 )
 
 func ResourceNativeUserLink() *schema.Resource {
@@ -22,7 +23,7 @@ func ResourceNativeUserLink() *schema.Resource {
 		UpdateContext: resourceNativeUserLinkUpdate,
 		DeleteContext: resourceNativeUserLinkDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByKey("native user link", []string{"resource_id", "native_user_id", "formal_identity_type", "formal_identity_id"}, findNativeUserLink),
 		},
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -129,17 +130,14 @@ func resourceNativeUserLinkRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	switch info := res.Link.Identity.(type) {
-	case *corev1.NativeUserLink_User:
-		d.Set("formal_identity_id", info.User.Id)
-	case *corev1.NativeUserLink_Group:
-		d.Set("formal_identity_id", info.Group.Id)
+	if identityType, identityId := nativeUserLinkIdentity(res.Link); identityType != "" {
+		d.Set("formal_identity_id", identityId)
+		d.Set("formal_identity_type", identityType)
 	}
 
 	// Should map to all fields of
 	d.Set("resource_id", res.Link.NativeUser.ResourceId)
 	d.Set("native_user_id", res.Link.NativeUser.Id)
-	d.Set("formal_identity_type", res.Link.Identity)
 	d.Set("termination_protection", res.Link.TerminationProtection)
 
 	d.SetId(res.Link.Id)
@@ -147,6 +145,43 @@ func resourceNativeUserLinkRead(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// nativeUserLinkIdentity returns the type and the ID of the Formal Identity of
+// a link, or empty strings if it isn't a user or a group.
+func nativeUserLinkIdentity(link *corev1.NativeUserLink) (string, string) {
+	switch info := link.Identity.(type) {
+	case *corev1.NativeUserLink_User:
+		return "user", info.User.Id
+	case *corev1.NativeUserLink_Group:
+		return "group", info.Group.Id
+	}
+	return "", ""
+}
+
+// findNativeUserLink returns the ID of the link between the native user of
+// the resource and the Formal Identity of key.
+func findNativeUserLink(ctx context.Context, c *clients.Clients, key []string) (string, error) {
+	resourceId, nativeUserId, identityType, identityId := key[0], key[1], key[2], key[3]
+	filter, err := keyFilter("native_user_id", nativeUserId)
+	if err != nil {
+		return "", err
+	}
+	link, _, err := paging.Find(ctx, func(ctx context.Context, cursor string) ([]*corev1.NativeUserLink, string, error) {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.ListNativeUserIdentityLinks(ctx, &corev1.ListNativeUserIdentityLinksRequest{
+			Filter: filter,
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Links, res.NextCursor, nil
+	}, func(link *corev1.NativeUserLink) bool {
+		linkType, linkId := nativeUserLinkIdentity(link)
+		return link.NativeUser.GetResourceId() == resourceId && link.NativeUser.GetId() == nativeUserId && linkType == identityType && linkId == identityId
+	})
+	return link.GetId(), err
+}
+
 func resourceNativeUserLinkDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	c := meta.(*clients.Clients)
 
@@ -10,6 +10,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/samber/lo"
@@ -50,13 +51,15 @@ func ResourceResource() *schema.Resource {
 		ReadContext:   resourceDatastoreRead,
 		UpdateContext: resourceDatastoreUpdate,
 		DeleteContext: resourceDatastoreDelete,
+		CustomizeDiff: customdiff.All(customizeDiffTagsAll, customizeDiffDefaultSpaceID),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(25 * time.Minute),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
 		},
+		Identity: idIdentity("Resource"),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				// This description is used by the documentation generator and the language server.
@@ -119,20 +122,29 @@ func ResourceResource() *schema.Resource {
 			},
 			"space_id": {
 				// This description is used by the documentation generator and the language server.
-				Description: "The ID of the Space to create the Resource in.",
+				Description: "The ID of the Space to create the Resource in. Defaults to the provider `default_space_id`.",
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
+				Computed:    true,
 			},
 			"tags": {
 				// This description is used by the documentation generator and the language server.
-				Description: "Tags to apply to the Resource.",
+				Description: "Tags to apply to the Resource. Tags with the same key as a provider `default_tags` tag take precedence.",
 				Type:        schema.TypeMap,
 				Optional:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"tags_all": {
+				// This description is used by the documentation generator and the language server.
+				Description: "All tags of the Resource, including the ones inherited from the provider `default_tags`.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"aliases": {
 				// This description is used by the documentation generator and the language server.
 				Description: "Aliases to apply to the Resource.",
@@ -178,7 +190,10 @@ func resourceDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta a
 	environment := d.Get("environment").(string)
 	terminationProtection := d.Get("termination_protection").(bool)
 	spaceId := d.Get("space_id").(string)
-	tags := d.Get("tags").(map[string]any)
+	tags, err := mergeDefaultTags(c.DefaultTags, d.Get("tags").(map[string]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	aliases, err := getAliasesFromResourceData(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -196,14 +211,9 @@ func resourceDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta a
 	}
 
 	for key, value := range tags {
-		valueString, ok := value.(string)
-		if !ok {
-			return diag.FromErr(fmt.Errorf("error reading tag value"))
-		}
-
 		msg.Tags = append(msg.Tags, &corev1.ResourceTag{
 			Key:   key,
-			Value: valueString,
+			Value: value,
 		})
 	}
 
@@ -270,7 +280,11 @@ func resourceDatastoreRead(ctx context.Context, d *schema.ResourceData, meta any
 		}
 		tags[tag.Key] = tag.Value
 	}
-	d.Set("tags", tags)
+	d.Set("tags_all", tags)
+	d.Set("tags", ignoreDefaultTags(tags, c.DefaultTags, d.Get("tags").(map[string]any)))
+	if err := setIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -279,7 +293,7 @@ func resourceDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta a
 	c := meta.(*clients.Clients)
 	datastoreId := d.Id()
 
-	fieldsThatCanChange := []string{"name", "environment", "hostname", "port", "termination_protection", "space_id", "tags", "aliases"}
+	fieldsThatCanChange := []string{"name", "environment", "hostname", "port", "termination_protection", "space_id", "tags", "tags_all", "aliases"}
 	if d.HasChangesExcept(fieldsThatCanChange...) {
 		return diag.Errorf("At the moment you can only update the following fields: %s. If you'd like to update other fields, please message the Formal team and we're happy to help.", strings.Join(fieldsThatCanChange, ", "))
 	}
@@ -311,17 +325,16 @@ func resourceDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta a
 		req.Port = &port
 	}
 
-	if d.HasChange("tags") {
-		tags := d.Get("tags").(map[string]any)
+	if d.HasChanges("tags", "tags_all") {
+		tags, err := mergeDefaultTags(c.DefaultTags, d.Get("tags").(map[string]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		req.Tags = &corev1.UpdateResourceRequest_UpdateResourceTag{Tags: make([]*corev1.ResourceTag, 0, len(tags))}
 		for key, value := range tags {
-			valueString, ok := value.(string)
-			if !ok {
-				return diag.FromErr(fmt.Errorf("error reading tag value"))
-			}
 			req.Tags.Tags = append(req.Tags.Tags, &corev1.ResourceTag{
 				Key:   key,
-				Value: valueString,
+				Value: value,
 			})
 		}
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -19,7 +21,7 @@ func ResourceResourceClassifierConfiguration() *schema.Resource {
 		UpdateContext: resourceResourceClassifierConfigurationUpdate,
 		DeleteContext: resourceResourceClassifierConfigurationDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceResourceClassifierConfigurationImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -120,10 +122,15 @@ func resourceResourceClassifierConfigurationRead(ctx context.Context, d *schema.
 
 	response, err := c.Grpc.Sdk.ResourceServiceClient.GetResourceClassifierConfiguration(ctx, &corev1.GetResourceClassifierConfigurationRequest{ResourceId: resourceId})
 	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			tflog.Warn(ctx, "The Resource Classifier Configuration was not found, which means it may have been deleted without using this Terraform config.", map[string]any{"err": err})
+			d.SetId("")
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 
-	d.Set("id", response.ResourceClassifierConfiguration.Id)
+	d.SetId(response.ResourceClassifierConfiguration.Id)
 	d.Set("resource_id", response.ResourceClassifierConfiguration.ResourceId)
 	d.Set("preference", response.ResourceClassifierConfiguration.Preference)
 	d.Set("ai_analysis_timeout_seconds", response.ResourceClassifierConfiguration.AiAnalysisTimeoutSeconds)
@@ -135,6 +142,13 @@ func resourceResourceClassifierConfigurationRead(ctx context.Context, d *schema.
 	return diags
 }
 
+// resourceResourceClassifierConfigurationImport imports a configuration by the
+// ID of its resource, which is how the API looks configurations up.
+func resourceResourceClassifierConfigurationImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("resource_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceResourceClassifierConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	c := meta.(*clients.Clients)
 
@@ -12,6 +12,7 @@ import (
 
 	corev1 "github.com/formalco/go-sdk/v3/core/v1"
 	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
 )
 
 func ResourceResourceHostname() *schema.Resource {
@@ -27,7 +28,7 @@ func ResourceResourceHostname() *schema.Resource {
 			Create: schema.DefaultTimeout(25 * time.Minute),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByKey("resource hostname", []string{"resource_id", "hostname"}, findResourceHostname),
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -122,6 +123,30 @@ func resourceResourceHostnameRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// findResourceHostname returns the ID of the hostname of key registered for
+// the resource of key.
+func findResourceHostname(ctx context.Context, c *clients.Clients, key []string) (string, error) {
+	resourceId, hostname := key[0], key[1]
+	filter, err := keyFilter("hostname", hostname)
+	if err != nil {
+		return "", err
+	}
+	resourceHostname, _, err := paging.Find(ctx, func(ctx context.Context, cursor string) ([]*corev1.ResourceHostname, string, error) {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.ListResourceHostnames(ctx, &corev1.ListResourceHostnamesRequest{
+			Filter: filter,
+			Limit:  paging.PageSize,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.ResourceHostnames, res.NextCursor, nil
+	}, func(resourceHostname *corev1.ResourceHostname) bool {
+		return resourceHostname.Resource.GetId() == resourceId && resourceHostname.Hostname == hostname
+	})
+	return resourceHostname.GetId(), err
+}
+
 func resourceResourceHostnameUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// use the meta value to retrieve your client from the provider configure method
 	c := meta.(*clients.Clients)
@@ -12,6 +12,13 @@ import (
 	"github.com/formalco/terraform-provider-formal/formal/clients"
 )
 
+var satelliteTypes = []string{
+	"ai",
+	"data_discovery",
+	"data_classifier",
+	"policy_data_loader",
+}
+
 func ResourceSatellite() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -20,6 +27,7 @@ func ResourceSatellite() *schema.Resource {
 		ReadContext:   resourceSatelliteRead,
 		UpdateContext: resourceSatelliteUpdate,
 		DeleteContext: resourceSatelliteDelete,
+		CustomizeDiff: customizeDiffDefaultSpaceID,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(25 * time.Minute),
@@ -43,22 +51,18 @@ func ResourceSatellite() *schema.Resource {
 			},
 			"satellite_type": {
 				// This description is used by the documentation generator and the language server.
-				Description: "The type of satellite. Must be one of: `ai`, `data_discovery`, `data_classifier` (deprecated), or `policy_data_loader`.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"ai",
-					"data_discovery",
-					"data_classifier",
-					"policy_data_loader",
-				}, false),
+				Description:  "The type of satellite. Must be one of: `ai`, `data_discovery`, `data_classifier` (deprecated), or `policy_data_loader`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(satelliteTypes, false),
 			},
 			"tls_cert": {
 				// This description is used by the documentation generator and the language server.
-				Description: "TLS certificate of the Satellite.",
+				Description: "TLS certificate of the Satellite. Always empty: Satellites have no TLS certificate, and this attribute used to hold a copy of `api_key`.",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Deprecated:  "Satellites have no TLS certificate, so this attribute is always empty. It will be removed in a future release.",
 			},
 			"api_key": {
 				// This description is used by the documentation generator and the language server.
@@ -76,10 +80,10 @@ func ResourceSatellite() *schema.Resource {
 			},
 			"space_id": {
 				// This description is used by the documentation generator and the language server.
-				Description: "The ID of the Space to create the Satellite in.",
+				Description: "The ID of the Space to create the Satellite in. Defaults to the provider `default_space_id`.",
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
+				Computed:    true,
 			},
 		},
 	}
@@ -140,8 +144,9 @@ func resourceSatelliteRead(ctx context.Context, d *schema.ResourceData, meta any
 			return diag.FromErr(err)
 		}
 		d.Set("api_key", res.ApiKey)
-		d.Set("tls_cert", res.ApiKey)
 	}
+	// Clear the copy of the API key earlier versions stored in tls_cert.
+	d.Set("tls_cert", "")
 	d.SetId(res.Satellite.Id)
 
 	return diags
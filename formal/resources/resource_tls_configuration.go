@@ -2,9 +2,11 @@ package resource
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,8 +32,8 @@ func ResourceTlsConfiguration() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Version: 0,
-				Type:    resourcePolicyInstanceResourceV0().CoreConfigSchema().ImpliedType(),
-				Upgrade: resourcePolicyStateUpgradeV0,
+				Type:    resourceTlsConfigurationInstanceResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceTlsConfigurationStateUpgradeV0,
 			},
 		},
 		Schema: map[string]*schema.Schema{
@@ -159,6 +161,11 @@ func resourceTlsConfigurationRead(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := c.Grpc.Sdk.ResourceServiceClient.GetResourceTlsConfiguration(ctx, &corev1.GetResourceTlsConfigurationRequest{Id: &id})
 	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			tflog.Warn(ctx, "The Resource TLS Configuration was not found, which means it may have been deleted without using this Terraform config.", map[string]any{"err": err})
+			d.SetId("")
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 
@@ -231,3 +238,59 @@ func resourceTlsConfigurationDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// resourceTlsConfigurationInstanceResourceV0 decodes version 0 states. The
+// schema of version 0 wasn't kept, so these are the attributes of version 1, of
+// which a version 0 state has at most a subset.
+func resourceTlsConfigurationInstanceResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tls_config": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tls_min_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_ca_truststore": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_client_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_client_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_client_cert_is_env": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tls_client_key_is_env": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceTlsConfigurationStateUpgradeV0 keeps the state as is, and the next
+// refresh reads any attribute it lacks from the API.
+func resourceTlsConfigurationStateUpgradeV0(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+	if rawState == nil {
+		return nil, fmt.Errorf("TLS configuration resource state upgrade failed, state is nil")
+	}
+
+	return rawState, nil
+}
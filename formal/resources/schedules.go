@@ -0,0 +1,32 @@
+package resource
+
+import (
+	"slices"
+
+	"github.com/robfig/cron/v3"
+)
+
+// predefinedSchedules are the schedules of formal_data_discovery that aren't
+// cron expressions.
+var predefinedSchedules = []string{"6h", "12h", "18h", "24h"}
+
+// secondsCronParser parses cron expressions starting with a seconds field,
+// such as the worker_schedule of formal_policy_data_loader.
+var secondsCronParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ValidateSchedule checks the schedule of a formal_data_discovery, one of the
+// predefined schedules or a standard cron expression.
+func ValidateSchedule(schedule string) error {
+	if slices.Contains(predefinedSchedules, schedule) {
+		return nil
+	}
+	_, err := cron.ParseStandard(schedule)
+	return err
+}
+
+// ValidateWorkerSchedule checks the worker_schedule of a
+// formal_policy_data_loader, a cron expression starting with a seconds field.
+func ValidateWorkerSchedule(schedule string) error {
+	_, err := secondsCronParser.Parse(schedule)
+	return err
+}
@@ -0,0 +1,38 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func defaultSpaceID(meta any) string {
+	c, ok := meta.(*clients.Clients)
+	if !ok || c == nil {
+		return ""
+	}
+	return c.DefaultSpaceID
+}
+
+// customizeDiffDefaultSpaceID plans space_id as the provider default_space_id
+// when a resource is created without one. An unset space_id without a default
+// space keeps meaning the root of the organization.
+//
+// Existing objects without space_id stay in their space when a default space
+// is set, so that setting default_space_id doesn't move them. CustomizeDiff
+// can't return warnings, so the provider server warns of objects planned
+// outside of the default space, see planWarningsServer.
+func customizeDiffDefaultSpaceID(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("space_id").IsNull() {
+		return nil
+	}
+
+	defaultSpaceID := defaultSpaceID(meta)
+	if defaultSpaceID != "" && d.Id() != "" {
+		return nil
+	}
+	return d.SetNew("space_id", defaultSpaceID)
+}
@@ -0,0 +1,51 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func TestCustomizeDiffDefaultSpaceID(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"space_id": {Type: schema.TypeString, Optional: true, Computed: true},
+		},
+		CustomizeDiff: customizeDiffDefaultSpaceID,
+	}
+
+	// An empty id plans the creation of the resource.
+	plannedSpaceID := func(t *testing.T, defaultSpaceID, id, stateSpaceID string, configSpaceID cty.Value) string {
+		t.Helper()
+
+		raw := map[string]any{}
+		if !configSpaceID.IsNull() {
+			raw["space_id"] = configSpaceID.AsString()
+		}
+		state := &terraform.InstanceState{
+			ID:        id,
+			RawConfig: cty.ObjectVal(map[string]cty.Value{"space_id": configSpaceID}),
+		}
+		if id != "" {
+			state.Attributes = map[string]string{"id": id, "space_id": stateSpaceID}
+		}
+
+		diff, err := r.SimpleDiff(t.Context(), state, terraform.NewResourceConfigRaw(raw), &clients.Clients{DefaultSpaceID: defaultSpaceID})
+		require.NoError(t, err)
+		if attr, ok := diff.Attributes["space_id"]; ok {
+			return attr.New
+		}
+		return stateSpaceID
+	}
+
+	require.Equal(t, "space_default", plannedSpaceID(t, "space_default", "", "", cty.NullVal(cty.String)), "unset space_id uses the default space")
+	require.Equal(t, "", plannedSpaceID(t, "", "resource_01abc", "space_other", cty.NullVal(cty.String)), "unset space_id without default space means the root")
+	require.Equal(t, "space_other", plannedSpaceID(t, "space_default", "", "", cty.StringVal("space_other")), "space_id set in the configuration wins")
+	require.Equal(t, "", plannedSpaceID(t, "space_default", "resource_01abc", "", cty.NullVal(cty.String)), "existing resources aren't moved to the default space")
+	require.Equal(t, "space_other", plannedSpaceID(t, "space_default", "resource_01abc", "space_other", cty.NullVal(cty.String)), "existing resources stay in their space")
+}
@@ -0,0 +1,124 @@
+package resource
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/formalco/terraform-provider-formal/formal/api"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/fakeapi"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files with the upgraded states")
+
+// stateUpgraderCases lists the resources with state upgraders. Each has a raw
+// state fixture testdata/state_upgraders/<type>/v<N>.json for every version N
+// it upgrades from, and the state it is expected to upgrade to in
+// v<N>.golden.json.
+var stateUpgraderCases = []struct {
+	resourceType string
+	resource     func() *schema.Resource
+	// objects are stored in the fake API for the upgraders reading it.
+	objects map[protoreflect.FullName]string
+}{
+	{
+		resourceType: "formal_connector_configuration",
+		resource:     ResourceConnectorConfiguration,
+	},
+	{
+		resourceType: "formal_integration_mdm",
+		resource:     ResourceIntegrationMDM,
+	},
+	{
+		resourceType: "formal_permission",
+		resource:     ResourcePermission,
+		objects: map[protoreflect.FullName]string{
+			"core.v1.Permission": `{"id": "permission_golden", "status": "dry-run"}`,
+		},
+	},
+	{
+		resourceType: "formal_resource_health_check",
+		resource:     ResourceHealthCheck,
+	},
+	{
+		resourceType: "formal_resource_tls_configuration",
+		resource:     ResourceTlsConfiguration,
+	},
+}
+
+func TestStateUpgraders(t *testing.T) {
+	for _, tc := range stateUpgraderCases {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			r := tc.resource()
+			require.NotEmpty(t, r.StateUpgraders)
+			meta := newStateUpgraderClients(t, tc.objects)
+
+			dir := filepath.Join("testdata", "state_upgraders", tc.resourceType)
+			for _, upgrader := range r.StateUpgraders {
+				t.Run(fmt.Sprintf("v%d", upgrader.Version), func(t *testing.T) {
+					raw, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("v%d.json", upgrader.Version)))
+					require.NoError(t, err, "missing fixture of schema version %d", upgrader.Version)
+
+					var state map[string]any
+					require.NoError(t, json.Unmarshal(raw, &state))
+					state = upgradeState(t, r, upgrader.Version, state, meta)
+					for attr := range state {
+						require.Contains(t, r.Schema, attr, "upgraded state has an attribute missing from the schema")
+					}
+
+					got, err := json.MarshalIndent(state, "", "  ")
+					require.NoError(t, err)
+					golden := filepath.Join(dir, fmt.Sprintf("v%d.golden.json", upgrader.Version))
+					if *updateGolden {
+						require.NoError(t, os.WriteFile(golden, append(got, '\n'), 0o644))
+					}
+					want, err := os.ReadFile(golden)
+					require.NoError(t, err)
+					require.JSONEq(t, string(want), string(got))
+				})
+			}
+		})
+	}
+}
+
+// upgradeState runs the upgraders of r on a raw state of the given schema
+// version, in order, as Terraform does before reading a resource.
+func upgradeState(t *testing.T, r *schema.Resource, version int, state map[string]any, meta any) map[string]any {
+	t.Helper()
+
+	for _, upgrader := range r.StateUpgraders {
+		if upgrader.Version != version {
+			continue
+		}
+		var err error
+		state, err = upgrader.Upgrade(t.Context(), state, meta)
+		require.NoError(t, err, "upgrading from schema version %d", version)
+		version++
+	}
+	require.Equal(t, r.SchemaVersion, version, "no upgrader from schema version %d", version)
+	return state
+}
+
+// newStateUpgraderClients returns the clients of a provider configured with
+// a fake API storing the given objects.
+func newStateUpgraderClients(t *testing.T, objects map[protoreflect.FullName]string) *clients.Clients {
+	t.Helper()
+
+	server := fakeapi.NewServer(t)
+	for name, object := range objects {
+		_, err := server.Put(name, object)
+		require.NoError(t, err)
+	}
+
+	client, err := api.NewClient(fakeapi.APIKey, false, api.WithBaseURL(server.URL))
+	require.NoError(t, err)
+	return &clients.Clients{Grpc: client}
+}
@@ -0,0 +1,77 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+func defaultTags(meta any) map[string]string {
+	c, ok := meta.(*clients.Clients)
+	if !ok || c == nil {
+		return nil
+	}
+	return c.DefaultTags
+}
+
+// mergeDefaultTags returns the provider default tags overridden by the tags of
+// the resource.
+func mergeDefaultTags(defaults map[string]string, tags map[string]any) (map[string]string, error) {
+	merged := maps.Clone(defaults)
+	if merged == nil {
+		merged = make(map[string]string, len(tags))
+	}
+	for key, value := range tags {
+		valueString, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("error reading tag value")
+		}
+		merged[key] = valueString
+	}
+	return merged, nil
+}
+
+// ignoreDefaultTags returns the tags read from the API without the ones coming
+// from the provider default tags, so that they don't show as a diff on tags.
+// Tags set on the resource are kept even when they match a default tag.
+func ignoreDefaultTags(all, defaults map[string]string, configured map[string]any) map[string]string {
+	tags := make(map[string]string, len(all))
+	for key, value := range all {
+		if _, ok := configured[key]; !ok {
+			if defaultValue, ok := defaults[key]; ok && defaultValue == value {
+				continue
+			}
+		}
+		tags[key] = value
+	}
+	return tags
+}
+
+// customizeDiffTagsAll plans tags_all as the tags of the resource merged with
+// the provider default tags.
+func customizeDiffTagsAll(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	all, err := mergeDefaultTags(defaultTags(meta), d.Get("tags").(map[string]any))
+	if err != nil {
+		return err
+	}
+	if maps.Equal(all, toStringMap(d.Get("tags_all").(map[string]any))) {
+		return nil
+	}
+	return d.SetNew("tags_all", all)
+}
+
+func toStringMap(m map[string]any) map[string]string {
+	out := make(map[string]string, len(m))
+	for key, value := range m {
+		out[key], _ = value.(string)
+	}
+	return out
+}
@@ -0,0 +1,31 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaults := map[string]string{"owner": "platform", "managed_by": "terraform"}
+
+	merged, err := mergeDefaultTags(defaults, map[string]any{"owner": "data", "cost_center": "42"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"owner": "data", "managed_by": "terraform", "cost_center": "42"}, merged)
+	require.Equal(t, "platform", defaults["owner"], "defaults must not be modified")
+
+	merged, err = mergeDefaultTags(nil, map[string]any{"cost_center": "42"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"cost_center": "42"}, merged)
+}
+
+func TestIgnoreDefaultTags(t *testing.T) {
+	defaults := map[string]string{"owner": "platform", "managed_by": "terraform"}
+	all := map[string]string{"owner": "platform", "managed_by": "console", "cost_center": "42"}
+
+	// managed_by was changed outside of Terraform, so it must show as a diff.
+	require.Equal(t, map[string]string{"managed_by": "console", "cost_center": "42"}, ignoreDefaultTags(all, defaults, map[string]any{}))
+
+	// owner is also set on the resource, so it is kept.
+	require.Equal(t, map[string]string{"owner": "platform", "managed_by": "console", "cost_center": "42"}, ignoreDefaultTags(all, defaults, map[string]any{"owner": "platform"}))
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+)
+
+// spaceResources are the resources created in the provider default_space_id,
+// by type name, with the name of their objects in warnings.
+var spaceResources = map[string]string{
+	"formal_connector": "Connector",
+	"formal_resource":  "Resource",
+	"formal_satellite": "Satellite",
+}
+
+// planWarningsServer serves primary, and warns when a plan creates an object
+// in, or moves it to, a space other than the provider default_space_id. SDKv2
+// CustomizeDiff can't return warnings.
+type planWarningsServer struct {
+	tfprotov5.ProviderServer
+	primary *schema.Provider
+}
+
+func (s planWarningsServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp.PlannedState == nil {
+		return resp, err
+	}
+	kind, ok := spaceResources[req.TypeName]
+	c, _ := s.primary.Meta().(*clients.Clients)
+	if !ok || c == nil || c.DefaultSpaceID == "" {
+		return resp, nil
+	}
+
+	ty := s.primary.ResourcesMap[req.TypeName].CoreConfigSchema().ImpliedType()
+	prior := cty.NullVal(ty)
+	if req.PriorState != nil {
+		if prior, err = msgpack.Unmarshal(req.PriorState.MsgPack, ty); err != nil {
+			return resp, nil
+		}
+	}
+	planned, err := msgpack.Unmarshal(resp.PlannedState.MsgPack, ty)
+	if err != nil {
+		return resp, nil
+	}
+	if diag := outsideDefaultSpaceWarning(kind, c.DefaultSpaceID, prior, planned); diag != nil {
+		resp.Diagnostics = append(resp.Diagnostics, diag)
+	}
+	return resp, nil
+}
+
+// outsideDefaultSpaceWarning warns when planned creates kind in, or moves it
+// to, a space other than defaultSpaceID. prior is null for a creation.
+func outsideDefaultSpaceWarning(kind, defaultSpaceID string, prior, planned cty.Value) *tfprotov5.Diagnostic {
+	if planned.IsNull() {
+		return nil
+	}
+	spaceID := planned.GetAttr("space_id")
+	if !spaceID.IsKnown() || spaceID.IsNull() || spaceID.AsString() == defaultSpaceID {
+		return nil
+	}
+	if !prior.IsNull() && prior.GetAttr("space_id").RawEquals(spaceID) {
+		return nil
+	}
+
+	location := "the root of the organization"
+	if spaceID.AsString() != "" {
+		location = fmt.Sprintf("Space %s", spaceID.AsString())
+	}
+	return &tfprotov5.Diagnostic{
+		Severity:  tfprotov5.DiagnosticSeverityWarning,
+		Summary:   fmt.Sprintf("%s is outside of the default Space", kind),
+		Detail:    fmt.Sprintf("The %s is planned in %s instead of the provider default_space_id %s. Remove space_id to use the default Space.", kind, location, defaultSpaceID),
+		Attribute: tftypes.NewAttributePath().WithAttributeName("space_id"),
+	}
+}
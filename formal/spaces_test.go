@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutsideDefaultSpaceWarning(t *testing.T) {
+	ty := cty.Object(map[string]cty.Type{"space_id": cty.String})
+	state := func(spaceID string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"space_id": cty.StringVal(spaceID)})
+	}
+	created := cty.NullVal(ty)
+
+	require.Nil(t, outsideDefaultSpaceWarning("Resource", "space_default", created, state("space_default")), "created in the default space")
+	require.NotNil(t, outsideDefaultSpaceWarning("Resource", "space_default", created, state("space_other")), "created in another space")
+	require.NotNil(t, outsideDefaultSpaceWarning("Resource", "space_default", created, state("")), "created in the root")
+	require.NotNil(t, outsideDefaultSpaceWarning("Resource", "space_default", state("space_default"), state("space_other")), "moved to another space")
+	require.Nil(t, outsideDefaultSpaceWarning("Resource", "space_default", state("space_other"), state("space_other")), "left in another space")
+	require.Nil(t, outsideDefaultSpaceWarning("Resource", "space_default", state("space_other"), cty.NullVal(ty)), "destroyed")
+	require.Nil(t, outsideDefaultSpaceWarning("Resource", "space_default", created, cty.ObjectVal(map[string]cty.Value{"space_id": cty.UnknownVal(cty.String)})), "space not known until apply")
+}
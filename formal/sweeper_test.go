@@ -0,0 +1,819 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	corev1 "github.com/formalco/go-sdk/v3/core/v1"
+	"github.com/formalco/terraform-provider-formal/formal/api"
+	"github.com/formalco/terraform-provider-formal/formal/clients"
+	"github.com/formalco/terraform-provider-formal/formal/paging"
+)
+
+// The sweepers delete the objects leaked by acceptance tests against a real
+// Formal organization, configured like the provider with FORMAL_API_KEY and
+// FORMAL_BASE_URL. They only touch objects named with FORMAL_SWEEP_PREFIX,
+// testAccPrefix by default, and run with:
+//
+//	go test ./formal -v -sweep=all
+//
+// Links are deleted first, then hostnames, and then the objects they point to.
+// Links aren't named, so they are swept when they point to an object that is.
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("formal_connector_listener_link", &resource.Sweeper{
+		Name: "formal_connector_listener_link",
+		F:    sweepConnectorListenerLinks,
+	})
+	resource.AddTestSweepers("formal_connector_satellite_link", &resource.Sweeper{
+		Name: "formal_connector_satellite_link",
+		F:    sweepConnectorSatelliteLinks,
+	})
+	resource.AddTestSweepers("formal_satellite_link", &resource.Sweeper{
+		Name: "formal_satellite_link",
+		F:    sweepSatelliteLinks,
+	})
+	resource.AddTestSweepers("formal_native_user_link", &resource.Sweeper{
+		Name: "formal_native_user_link",
+		F:    sweepNativeUserLinks,
+	})
+	resource.AddTestSweepers("formal_group_user_link", &resource.Sweeper{
+		Name: "formal_group_user_link",
+		F:    sweepGroupUserLinks,
+	})
+	resource.AddTestSweepers("formal_connector_hostname", &resource.Sweeper{
+		Name: "formal_connector_hostname",
+		F:    sweepConnectorHostnames,
+	})
+	resource.AddTestSweepers("formal_resource_hostname", &resource.Sweeper{
+		Name: "formal_resource_hostname",
+		F:    sweepResourceHostnames,
+	})
+	resource.AddTestSweepers("formal_connector_listener", &resource.Sweeper{
+		Name:         "formal_connector_listener",
+		Dependencies: []string{"formal_connector_listener_link"},
+		F:            sweepConnectorListeners,
+	})
+	resource.AddTestSweepers("formal_native_user", &resource.Sweeper{
+		Name:         "formal_native_user",
+		Dependencies: []string{"formal_native_user_link"},
+		F:            sweepNativeUsers,
+	})
+	resource.AddTestSweepers("formal_resource", &resource.Sweeper{
+		Name: "formal_resource",
+		Dependencies: []string{
+			"formal_native_user_link",
+			"formal_native_user",
+			"formal_resource_hostname",
+		},
+		F: sweepResources,
+	})
+	resource.AddTestSweepers("formal_connector", &resource.Sweeper{
+		Name: "formal_connector",
+		Dependencies: []string{
+			"formal_connector_listener_link",
+			"formal_connector_satellite_link",
+			"formal_connector_hostname",
+			"formal_connector_listener",
+		},
+		F: sweepConnectors,
+	})
+	resource.AddTestSweepers("formal_satellite", &resource.Sweeper{
+		Name: "formal_satellite",
+		Dependencies: []string{
+			"formal_connector_satellite_link",
+			"formal_satellite_link",
+		},
+		F: sweepSatellites,
+	})
+	resource.AddTestSweepers("formal_space", &resource.Sweeper{
+		Name: "formal_space",
+		Dependencies: []string{
+			"formal_resource",
+			"formal_connector",
+			"formal_satellite",
+		},
+		F: sweepSpaces,
+	})
+	resource.AddTestSweepers("formal_group", &resource.Sweeper{
+		Name:         "formal_group",
+		Dependencies: []string{"formal_group_user_link"},
+		F:            sweepGroups,
+	})
+	resource.AddTestSweepers("formal_user", &resource.Sweeper{
+		Name: "formal_user",
+		Dependencies: []string{
+			"formal_group_user_link",
+			"formal_native_user_link",
+		},
+		F: sweepUsers,
+	})
+	resource.AddTestSweepers("formal_policy", &resource.Sweeper{
+		Name: "formal_policy",
+		F:    sweepPolicies,
+	})
+}
+
+// sweepPrefix returns the prefix of the names of the objects to sweep.
+func sweepPrefix() string {
+	if prefix := os.Getenv("FORMAL_SWEEP_PREFIX"); prefix != "" {
+		return prefix
+	}
+	return testAccPrefix
+}
+
+// sweepClients returns the clients of a provider configured from the
+// environment.
+func sweepClients() (*clients.Clients, error) {
+	apiKey := os.Getenv("FORMAL_API_KEY")
+	if apiKey == "" {
+		return nil, errors.New("FORMAL_API_KEY must be set to run the sweepers")
+	}
+
+	var opts []api.Option
+	if baseURL := os.Getenv("FORMAL_BASE_URL"); baseURL != "" {
+		opts = append(opts, api.WithBaseURL(baseURL))
+	}
+	client, err := api.NewClient(apiKey, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &clients.Clients{Grpc: client}, nil
+}
+
+// sweepTarget is an object to delete.
+type sweepTarget struct {
+	id                    string
+	terminationProtection bool
+}
+
+// sweepTargets turns the termination protection of the targets off when set,
+// and deletes them. It keeps going on errors and returns them all.
+func sweepTargets(ctx context.Context, kind string, targets []sweepTarget, unprotect, remove func(ctx context.Context, id string) error) error {
+	var errs []error
+	for _, target := range targets {
+		log.Printf("[INFO] Sweeping %s %s", kind, target.id)
+		if target.terminationProtection {
+			if err := unprotect(ctx, target.id); err != nil {
+				errs = append(errs, fmt.Errorf("disabling termination protection of %s %s: %w", kind, target.id, err))
+				continue
+			}
+		}
+		if err := remove(ctx, target.id); err != nil {
+			errs = append(errs, fmt.Errorf("deleting %s %s: %w", kind, target.id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sweepList pages through the objects listed by page, and returns the targets
+// of those to sweep.
+func sweepList[T any](ctx context.Context, page paging.Page[T], target func(T) (sweepTarget, bool)) ([]sweepTarget, error) {
+	var targets []sweepTarget
+	for object, err := range paging.All(ctx, page) {
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := target(object); ok {
+			targets = append(targets, t)
+		}
+	}
+	return targets, nil
+}
+
+// sweepIDs returns the set of the IDs of targets, to sweep the links pointing
+// to them.
+func sweepIDs(targets []sweepTarget) map[string]bool {
+	ids := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		ids[target.id] = true
+	}
+	return ids
+}
+
+func sweepConnectorListenerLinks(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.ConnectorListenerLink, string, error) {
+		res, err := c.Grpc.Sdk.ConnectorServiceClient.ListConnectorListenerLinks(ctx, &corev1.ListConnectorListenerLinksRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.ConnectorListenerLinks, res.NextCursor, nil
+	}, func(link *corev1.ConnectorListenerLink) (sweepTarget, bool) {
+		if link.Connector == nil || !strings.HasPrefix(link.Connector.Name, sweepPrefix()) {
+			return sweepTarget{}, false
+		}
+		return sweepTarget{id: link.Id, terminationProtection: link.TerminationProtection}, true
+	})
+	if err != nil {
+		return fmt.Errorf("listing connector listener links: %w", err)
+	}
+
+	return sweepTargets(ctx, "connector listener link", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.UpdateConnectorListenerLink(ctx, &corev1.UpdateConnectorListenerLinkRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.DeleteConnectorListenerLink(ctx, &corev1.DeleteConnectorListenerLinkRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepConnectorSatelliteLinks(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	connectors, err := listSweptConnectors(ctx, c)
+	if err != nil {
+		return err
+	}
+	satellites, err := listSweptSatellites(ctx, c)
+	if err != nil {
+		return err
+	}
+	connectorIDs, satelliteIDs := sweepIDs(connectors), sweepIDs(satellites)
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.ConnectorSatelliteLink, string, error) {
+		res, err := c.Grpc.Sdk.ConnectorServiceClient.ListConnectorSatelliteLinks(ctx, &corev1.ListConnectorSatelliteLinksRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.ConnectorSatelliteLinks, res.NextCursor, nil
+	}, func(link *corev1.ConnectorSatelliteLink) (sweepTarget, bool) {
+		return sweepTarget{id: link.Id}, connectorIDs[link.ConnectorId] || satelliteIDs[link.SatelliteId]
+	})
+	if err != nil {
+		return fmt.Errorf("listing connector satellite links: %w", err)
+	}
+
+	// Connector satellite links have no termination protection.
+	return sweepTargets(ctx, "connector satellite link", targets, nil,
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.DeleteConnectorSatelliteLink(ctx, &corev1.DeleteConnectorSatelliteLinkRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepSatelliteLinks(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	satellites, err := listSweptSatellites(ctx, c)
+	if err != nil {
+		return err
+	}
+	satelliteIDs := sweepIDs(satellites)
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.SatelliteLink, string, error) {
+		res, err := c.Grpc.Sdk.SatelliteServiceClient.ListSatelliteLinks(ctx, &corev1.ListSatelliteLinksRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.SatelliteLinks, res.NextCursor, nil
+	}, func(link *corev1.SatelliteLink) (sweepTarget, bool) {
+		return sweepTarget{id: link.Id}, satelliteIDs[link.SourceSatelliteId] || satelliteIDs[link.TargetSatelliteId]
+	})
+	if err != nil {
+		return fmt.Errorf("listing satellite links: %w", err)
+	}
+
+	// Satellite links have no termination protection.
+	return sweepTargets(ctx, "satellite link", targets, nil,
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.SatelliteServiceClient.DeleteSatelliteLink(ctx, &corev1.DeleteSatelliteLinkRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepNativeUserLinks(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	// Native users are named after the database users, so the links are swept
+	// by the resource of their native user.
+	resources, err := listSweptResources(ctx, c)
+	if err != nil {
+		return err
+	}
+	resourceIDs := sweepIDs(resources)
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.NativeUserLink, string, error) {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.ListNativeUserIdentityLinks(ctx, &corev1.ListNativeUserIdentityLinksRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Links, res.NextCursor, nil
+	}, func(link *corev1.NativeUserLink) (sweepTarget, bool) {
+		return sweepTarget{id: link.Id, terminationProtection: link.TerminationProtection}, resourceIDs[link.NativeUser.GetResourceId()]
+	})
+	if err != nil {
+		return fmt.Errorf("listing native user links: %w", err)
+	}
+
+	return sweepTargets(ctx, "native user link", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.ResourceServiceClient.UpdateNativeUserIdentityLink(ctx, &corev1.UpdateNativeUserIdentityLinkRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ResourceServiceClient.DeleteNativeUserIdentityLink(ctx, &corev1.DeleteNativeUserIdentityLinkRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepNativeUsers(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	// Like their links, native users are swept by their resource.
+	resources, err := listSweptResources(ctx, c)
+	if err != nil {
+		return err
+	}
+	resourceIDs := sweepIDs(resources)
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.NativeUser, string, error) {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.ListNativeUsers(ctx, &corev1.ListNativeUsersRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.NativeUsers, res.NextCursor, nil
+	}, func(nativeUser *corev1.NativeUser) (sweepTarget, bool) {
+		return sweepTarget{id: nativeUser.Id, terminationProtection: nativeUser.TerminationProtection}, resourceIDs[nativeUser.ResourceId]
+	})
+	if err != nil {
+		return fmt.Errorf("listing native users: %w", err)
+	}
+
+	return sweepTargets(ctx, "native user", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.ResourceServiceClient.UpdateNativeUser(ctx, &corev1.UpdateNativeUserRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ResourceServiceClient.DeleteNativeUser(ctx, &corev1.DeleteNativeUserRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepGroupUserLinks(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	groups, err := paging.Collect(ctx, func(ctx context.Context, cursor string) ([]*corev1.Group, string, error) {
+		res, err := c.Grpc.Sdk.GroupServiceClient.ListGroups(ctx, &corev1.ListGroupsRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Groups, res.NextCursor, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing groups: %w", err)
+	}
+
+	var targets []sweepTarget
+	for _, group := range groups {
+		if !strings.HasPrefix(group.Name, sweepPrefix()) {
+			continue
+		}
+		links, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.UserGroupLink, string, error) {
+			res, err := c.Grpc.Sdk.GroupServiceClient.ListUserGroupLinks(ctx, &corev1.ListUserGroupLinksRequest{GroupId: group.Id, Limit: paging.PageSize, Cursor: cursor})
+			if err != nil {
+				return nil, "", err
+			}
+			return res.UserGroupLinks, res.NextCursor, nil
+		}, func(link *corev1.UserGroupLink) (sweepTarget, bool) {
+			return sweepTarget{id: link.Id}, link.Group.GetId() == group.Id
+		})
+		if err != nil {
+			return fmt.Errorf("listing the users of group %s: %w", group.Id, err)
+		}
+		targets = append(targets, links...)
+	}
+
+	// The termination protection of group user links is only enforced by the
+	// provider.
+	return sweepTargets(ctx, "group user link", targets, nil,
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.GroupServiceClient.DeleteUserGroupLink(ctx, &corev1.DeleteUserGroupLinkRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepConnectorHostnames(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.ConnectorHostname, string, error) {
+		res, err := c.Grpc.Sdk.ConnectorServiceClient.ListConnectorHostnames(ctx, &corev1.ListConnectorHostnamesRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.ConnectorHostnames, res.NextCursor, nil
+	}, func(hostname *corev1.ConnectorHostname) (sweepTarget, bool) {
+		if hostname.Connector == nil || !strings.HasPrefix(hostname.Connector.Name, sweepPrefix()) {
+			return sweepTarget{}, false
+		}
+		return sweepTarget{id: hostname.Id, terminationProtection: hostname.TerminationProtection}, true
+	})
+	if err != nil {
+		return fmt.Errorf("listing connector hostnames: %w", err)
+	}
+
+	return sweepTargets(ctx, "connector hostname", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.UpdateConnectorHostname(ctx, &corev1.UpdateConnectorHostnameRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.DeleteConnectorHostname(ctx, &corev1.DeleteConnectorHostnameRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepResourceHostnames(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.ResourceHostname, string, error) {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.ListResourceHostnames(ctx, &corev1.ListResourceHostnamesRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.ResourceHostnames, res.NextCursor, nil
+	}, func(hostname *corev1.ResourceHostname) (sweepTarget, bool) {
+		if !strings.HasPrefix(hostname.Name, sweepPrefix()) && (hostname.Resource == nil || !strings.HasPrefix(hostname.Resource.Name, sweepPrefix())) {
+			return sweepTarget{}, false
+		}
+		return sweepTarget{id: hostname.Id, terminationProtection: hostname.TerminationProtection}, true
+	})
+	if err != nil {
+		return fmt.Errorf("listing resource hostnames: %w", err)
+	}
+
+	return sweepTargets(ctx, "resource hostname", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.ResourceServiceClient.UpdateResourceHostname(ctx, &corev1.UpdateResourceHostnameRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ResourceServiceClient.DeleteResourceHostname(ctx, &corev1.DeleteResourceHostnameRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepConnectorListeners(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.ConnectorListener, string, error) {
+		res, err := c.Grpc.Sdk.ConnectorServiceClient.ListConnectorListeners(ctx, &corev1.ListConnectorListenersRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.ConnectorListeners, res.NextCursor, nil
+	}, func(listener *corev1.ConnectorListener) (sweepTarget, bool) {
+		return sweepTarget{id: listener.Id, terminationProtection: listener.TerminationProtection}, strings.HasPrefix(listener.Name, sweepPrefix())
+	})
+	if err != nil {
+		return fmt.Errorf("listing connector listeners: %w", err)
+	}
+
+	return sweepTargets(ctx, "connector listener", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.UpdateConnectorListener(ctx, &corev1.UpdateConnectorListenerRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.DeleteConnectorListener(ctx, &corev1.DeleteConnectorListenerRequest{Id: id})
+			return err
+		},
+	)
+}
+
+// listSweptResources returns the resources to sweep.
+func listSweptResources(ctx context.Context, c *clients.Clients) ([]sweepTarget, error) {
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.Resource, string, error) {
+		res, err := c.Grpc.Sdk.ResourceServiceClient.ListResources(ctx, &corev1.ListResourcesRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Resources, res.NextCursor, nil
+	}, func(r *corev1.Resource) (sweepTarget, bool) {
+		return sweepTarget{id: r.Id, terminationProtection: r.TerminationProtection}, strings.HasPrefix(r.Name, sweepPrefix())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing resources: %w", err)
+	}
+	return targets, nil
+}
+
+func sweepResources(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := listSweptResources(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	return sweepTargets(ctx, "resource", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.ResourceServiceClient.UpdateResource(ctx, &corev1.UpdateResourceRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ResourceServiceClient.DeleteResource(ctx, &corev1.DeleteResourceRequest{Id: id})
+			return err
+		},
+	)
+}
+
+// listSweptConnectors returns the connectors to sweep.
+func listSweptConnectors(ctx context.Context, c *clients.Clients) ([]sweepTarget, error) {
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.Connector, string, error) {
+		res, err := c.Grpc.Sdk.ConnectorServiceClient.ListConnectors(ctx, &corev1.ListConnectorsRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Connectors, res.NextCursor, nil
+	}, func(connector *corev1.Connector) (sweepTarget, bool) {
+		return sweepTarget{id: connector.Id, terminationProtection: connector.TerminationProtection}, strings.HasPrefix(connector.Name, sweepPrefix())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing connectors: %w", err)
+	}
+	return targets, nil
+}
+
+func sweepConnectors(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := listSweptConnectors(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	return sweepTargets(ctx, "connector", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.UpdateConnector(ctx, &corev1.UpdateConnectorRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.ConnectorServiceClient.DeleteConnector(ctx, &corev1.DeleteConnectorRequest{Id: id})
+			return err
+		},
+	)
+}
+
+// listSweptSatellites returns the satellites to sweep.
+func listSweptSatellites(ctx context.Context, c *clients.Clients) ([]sweepTarget, error) {
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.Satellite, string, error) {
+		res, err := c.Grpc.Sdk.SatelliteServiceClient.ListSatellites(ctx, &corev1.ListSatellitesRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Satellites, res.NextCursor, nil
+	}, func(satellite *corev1.Satellite) (sweepTarget, bool) {
+		return sweepTarget{id: satellite.Id, terminationProtection: satellite.TerminationProtection}, strings.HasPrefix(satellite.Name, sweepPrefix())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing satellites: %w", err)
+	}
+	return targets, nil
+}
+
+func sweepSatellites(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := listSweptSatellites(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	return sweepTargets(ctx, "satellite", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.SatelliteServiceClient.UpdateSatellite(ctx, &corev1.UpdateSatelliteRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.SatelliteServiceClient.DeleteSatellite(ctx, &corev1.DeleteSatelliteRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepSpaces(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.Space, string, error) {
+		res, err := c.Grpc.Sdk.SpaceServiceClient.ListSpaces(ctx, &corev1.ListSpacesRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Spaces, res.NextCursor, nil
+	}, func(space *corev1.Space) (sweepTarget, bool) {
+		return sweepTarget{id: space.Id, terminationProtection: space.TerminationProtection}, strings.HasPrefix(space.Name, sweepPrefix())
+	})
+	if err != nil {
+		return fmt.Errorf("listing spaces: %w", err)
+	}
+
+	return sweepTargets(ctx, "space", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.SpaceServiceClient.UpdateSpace(ctx, &corev1.UpdateSpaceRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.SpaceServiceClient.DeleteSpace(ctx, &corev1.DeleteSpaceRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepGroups(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.Group, string, error) {
+		res, err := c.Grpc.Sdk.GroupServiceClient.ListGroups(ctx, &corev1.ListGroupsRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Groups, res.NextCursor, nil
+	}, func(group *corev1.Group) (sweepTarget, bool) {
+		return sweepTarget{id: group.Id, terminationProtection: group.TerminationProtection}, strings.HasPrefix(group.Name, sweepPrefix())
+	})
+	if err != nil {
+		return fmt.Errorf("listing groups: %w", err)
+	}
+
+	return sweepTargets(ctx, "group", targets,
+		func(ctx context.Context, id string) error {
+			terminationProtection := false
+			_, err := c.Grpc.Sdk.GroupServiceClient.UpdateGroup(ctx, &corev1.UpdateGroupRequest{Id: id, TerminationProtection: &terminationProtection})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.GroupServiceClient.DeleteGroup(ctx, &corev1.DeleteGroupRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepUsers(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	// Machine users are swept by name, and human users by email.
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.User, string, error) {
+		res, err := c.Grpc.Sdk.UserServiceClient.ListUsers(ctx, &corev1.ListUsersRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Users, res.NextCursor, nil
+	}, func(user *corev1.User) (sweepTarget, bool) {
+		swept := strings.HasPrefix(user.GetMachine().GetName(), sweepPrefix()) || strings.HasPrefix(user.GetHuman().GetEmail(), sweepPrefix())
+		return sweepTarget{id: user.Id, terminationProtection: user.TerminationProtection}, swept
+	})
+	if err != nil {
+		return fmt.Errorf("listing users: %w", err)
+	}
+
+	return sweepTargets(ctx, "user", targets,
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.UserServiceClient.UpdateUser(ctx, &corev1.UpdateUserRequest{Id: id, TerminationProtection: false})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.UserServiceClient.DeleteUser(ctx, &corev1.DeleteUserRequest{Id: id})
+			return err
+		},
+	)
+}
+
+func sweepPolicies(_ string) error {
+	c, err := sweepClients()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	policies := map[string]*corev1.Policy{}
+	targets, err := sweepList(ctx, func(ctx context.Context, cursor string) ([]*corev1.Policy, string, error) {
+		res, err := c.Grpc.Sdk.PoliciesServiceClient.ListPolicies(ctx, &corev1.ListPoliciesRequest{Limit: paging.PageSize, Cursor: cursor})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Policies, res.NextCursor, nil
+	}, func(policy *corev1.Policy) (sweepTarget, bool) {
+		if !strings.HasPrefix(policy.Name, sweepPrefix()) {
+			return sweepTarget{}, false
+		}
+		policies[policy.Id] = policy
+		return sweepTarget{id: policy.Id, terminationProtection: policy.TerminationProtection}, true
+	})
+	if err != nil {
+		return fmt.Errorf("listing policies: %w", err)
+	}
+
+	return sweepTargets(ctx, "policy", targets,
+		func(ctx context.Context, id string) error {
+			// UpdatePolicy replaces every field of the policy.
+			policy := policies[id]
+			_, err := c.Grpc.Sdk.PoliciesServiceClient.UpdatePolicy(ctx, &corev1.UpdatePolicyRequest{
+				Id:                    id,
+				Name:                  policy.Name,
+				Description:           policy.Description,
+				Code:                  policy.Code,
+				Status:                policy.Status,
+				TerminationProtection: false,
+			})
+			return err
+		},
+		func(ctx context.Context, id string) error {
+			_, err := c.Grpc.Sdk.PoliciesServiceClient.DeletePolicy(ctx, &corev1.DeletePolicyRequest{Id: id})
+			return err
+		},
+	)
+}
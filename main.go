@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 
 	provider "github.com/formalco/terraform-provider-formal/formal"
 )
@@ -31,14 +33,20 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{
-		Debug: debugMode,
-
-		// TODO: update this string with the full name of your provider as used in your configs
-		ProviderAddr: "registry.terraform.io/formalco/formal",
+	// The SDKv2 provider and the terraform-plugin-framework provider are served
+	// together, so that resources can be migrated to the framework one by one.
+	providerServer, err := provider.NewProviderServer(context.Background(), version)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		ProviderFunc: provider.New(version),
+	var serveOpts []tf5server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
 	}
 
-	plugin.Serve(opts)
+	err = tf5server.Serve("registry.terraform.io/formalco/formal", providerServer, serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 }